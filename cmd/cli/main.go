@@ -4,33 +4,56 @@ import (
 	"bufio"
 	"context"
 	"crypto/tls"
+	"fmt"
 	"log"
+	"net"
 	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/charithe/calculator/pkg/calculator"
+	"github.com/charithe/calculator/pkg/v1pb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
 
+// unixScheme is the prefix of addresses referring to a Unix domain socket, as in unix:///run/calculator.sock
+const unixScheme = "unix://"
+
 var (
 	app = kingpin.New("Calculator CLI", "A toy RPC calculator CLI")
 
-	addr      = app.Flag("addr", "Server address").Default("localhost:8080").String()
+	addr      = app.Flag("addr", "Server address (unix:///path for a Unix domain socket)").Default("localhost:8080").String()
 	insecure  = app.Flag("insecure", "Trust unknown CAs").Bool()
 	plaintext = app.Flag("plaintext", "Use unencrypted connection").Bool()
 
-	streamCmd = app.Command("stream", "Stream mode")
-	batchCmd  = app.Command("batch", "Batch mode")
-	batchExpr = batchCmd.Arg("expr", "Expression (space separated)").Strings()
+	streamCmd      = app.Command("stream", "Stream mode")
+	interactiveCmd = app.Command("interactive", "Interactive mode")
+	batchCmd       = app.Command("batch", "Batch mode")
+	batchInfix     = batchCmd.Flag("infix", "Parse the expression as infix notation").Bool()
+	batchPrecision = batchCmd.Flag("precision", "Arithmetic precision").Default("float64").Enum("float64", "bigfloat", "exact")
+	batchFloatPrec = batchCmd.Flag("float_precision", "Mantissa size in bits for bigfloat precision").Uint32()
+	batchExpr      = batchCmd.Arg("expr", "Expression (space separated)").Strings()
+
+	explainCmd       = app.Command("explain", "Show the state of the stack after each token")
+	explainInfix     = explainCmd.Flag("infix", "Parse the expression as infix notation").Bool()
+	explainPrecision = explainCmd.Flag("precision", "Arithmetic precision").Default("float64").Enum("float64", "bigfloat", "exact")
+	explainFloatPrec = explainCmd.Flag("float_precision", "Mantissa size in bits for bigfloat precision").Uint32()
+	explainExpr      = explainCmd.Arg("expr", "Expression (space separated)").Strings()
 )
 
 func main() {
 	switch kingpin.MustParse(app.Parse(os.Args[1:])) {
 	case streamCmd.FullCommand():
 		doStream()
+	case interactiveCmd.FullCommand():
+		doInteractive()
 	case batchCmd.FullCommand():
 		doBatch()
+	case explainCmd.FullCommand():
+		doExplain()
 	}
 }
 
@@ -42,29 +65,90 @@ func doStream() {
 	}
 	defer client.Close()
 
-	log.Printf("Enter each operator or operand in a new line. Press Ctrl+D to end")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	tokChan := make(chan string)
-	go func() {
-		defer close(tokChan)
+	stream, err := client.OpenStream(ctx)
+	if err != nil {
+		log.Printf("Streaming call failed: %v", err)
+		os.Exit(1)
+	}
+	defer stream.Close()
 
-		scanner := bufio.NewScanner(os.Stdin)
-		for scanner.Scan() {
-			tokChan <- scanner.Text()
-		}
+	log.Printf("Enter each operator, operand or stack word (dup, swap, drop, rot, clear) in a new line. Press Ctrl+D to end")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		if err := stream.Send(scanner.Text()); err != nil {
+			if _, ok := err.(*strconv.NumError); ok {
+				log.Printf("Invalid token: %v", err)
+				continue
+			}
 
-		if err := scanner.Err(); err != nil {
-			log.Printf("Failed to read stream: %v", err)
+			log.Printf("Streaming call failed: %v", err)
+			os.Exit(1)
 		}
-	}()
+	}
 
-	result, err := client.EvaluateStream(tokChan)
+	if err := scanner.Err(); err != nil {
+		log.Printf("Failed to read stream: %v", err)
+	}
+
+	resp, err := stream.Result()
 	if err != nil {
 		log.Printf("Streaming call failed: %v", err)
 		os.Exit(1)
 	}
 
-	log.Printf("Result: %f", result)
+	log.Printf("Result: %f", resp.Result)
+}
+
+func doInteractive() {
+	client, err := createClient()
+	if err != nil {
+		log.Printf("Failed to connect to server: %v", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.EvaluateInteractive(ctx)
+	if err != nil {
+		log.Printf("Interactive call failed: %v", err)
+		os.Exit(1)
+	}
+
+	log.Printf("Enter each operator or operand in a new line. Press Ctrl+D to end")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		resp, err := stream.Push(scanner.Text())
+		if err != nil {
+			if _, ok := err.(*strconv.NumError); ok {
+				log.Printf("Invalid token: %v", err)
+				continue
+			}
+
+			log.Printf("Interactive call failed: %v", err)
+			os.Exit(1)
+		}
+
+		if resp.Error != "" {
+			log.Printf("Rejected: %s", resp.Error)
+		}
+		log.Printf("Top: %f Depth: %d", resp.StackTop, resp.StackDepth)
+	}
+
+	if err := scanner.Err(); err != nil {
+		log.Printf("Failed to read input: %v", err)
+	}
+
+	if err := stream.Close(); err != nil {
+		log.Printf("Interactive call failed: %v", err)
+		os.Exit(1)
+	}
 }
 
 func doBatch() {
@@ -75,7 +159,21 @@ func doBatch() {
 	}
 	defer client.Close()
 
-	result, err := client.EvaluateBatch(context.Background(), *batchExpr)
+	if *batchPrecision != "float64" {
+		doBatchDecimal(client)
+		return
+	}
+
+	var result float64
+	if *batchInfix {
+		expr := strings.Join(*batchExpr, " ")
+		result, err = client.EvaluateInfix(context.Background(), expr)
+		exitOnParseError(expr, err)
+	} else {
+		result, err = client.EvaluateBatch(context.Background(), *batchExpr)
+	}
+	exitOnEvaluationError(err)
+
 	if err != nil {
 		log.Printf("Batch call failed: %v", err)
 		os.Exit(1)
@@ -84,6 +182,123 @@ func doBatch() {
 	log.Printf("Result: %f", result)
 }
 
+func doBatchDecimal(client *calculator.Client) {
+	precision := parsePrecision(*batchPrecision)
+
+	var result string
+	var err error
+	if *batchInfix {
+		expr := strings.Join(*batchExpr, " ")
+		result, err = client.EvaluateInfixDecimal(context.Background(), expr, precision, *batchFloatPrec)
+		exitOnParseError(expr, err)
+	} else {
+		result, err = client.EvaluateBatchDecimal(context.Background(), *batchExpr, precision, *batchFloatPrec)
+	}
+	exitOnEvaluationError(err)
+
+	if err != nil {
+		log.Printf("Batch call failed: %v", err)
+		os.Exit(1)
+	}
+
+	log.Printf("Result: %s", result)
+}
+
+func doExplain() {
+	client, err := createClient()
+	if err != nil {
+		log.Printf("Failed to connect to server: %v", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	notation := v1pb.RPN
+	if *explainInfix {
+		notation = v1pb.INFIX
+	}
+
+	expr := strings.Join(*explainExpr, " ")
+	resp, err := client.Explain(context.Background(), expr, notation, parsePrecision(*explainPrecision), *explainFloatPrec)
+	exitOnParseError(expr, err)
+
+	if err != nil {
+		eerr, ok := err.(*calculator.EvaluationError)
+		if !ok {
+			log.Printf("Explain call failed: %v", err)
+			os.Exit(1)
+		}
+
+		printTrace(eerr.Trace)
+		if eerr.Pos < 0 {
+			log.Printf("Evaluation failed [%s]: %s", eerr.Reason, eerr.Msg)
+		} else {
+			log.Printf("Evaluation failed [%s]: %s\n\t%s\n\t%s^", eerr.Reason, eerr.Msg, expr, strings.Repeat(" ", eerr.Pos))
+		}
+		os.Exit(1)
+	}
+
+	printTrace(resp.Trace)
+	log.Printf("Result: %s", resp.ResultDecimal)
+}
+
+// printTrace renders the trace as a table with a row per token.
+func printTrace(trace *v1pb.Trace) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tTOKEN\tSTACK")
+	for i, step := range trace.GetSteps() {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", i+1, step.Token, strings.Join(step.Stack, " "))
+	}
+
+	if trace.GetTruncated() {
+		fmt.Fprintln(w, "...\t\t(trace truncated)")
+	}
+	w.Flush()
+}
+
+func parsePrecision(precision string) v1pb.Precision {
+	switch precision {
+	case "bigfloat":
+		return v1pb.BIG_FLOAT
+	case "exact":
+		return v1pb.EXACT
+	default:
+		return v1pb.FLOAT64
+	}
+}
+
+func exitOnParseError(expr string, err error) {
+	if perr, ok := err.(*calculator.ParseError); ok {
+		log.Printf("Invalid expression: %s\n\t%s\n\t%s^", perr.Msg, expr, strings.Repeat(" ", perr.Pos))
+		os.Exit(1)
+	}
+}
+
+// exitOnEvaluationError points at the token of the batch expression that the server rejected.
+func exitOnEvaluationError(err error) {
+	eerr, ok := err.(*calculator.EvaluationError)
+	if !ok {
+		return
+	}
+
+	expr := strings.Join(*batchExpr, " ")
+	pos := eerr.Pos
+	if !*batchInfix && eerr.TokenIndex >= 0 && eerr.TokenIndex < len(*batchExpr) {
+		// the tokens were given as separate arguments so the offset is relative to the space-joined expression
+		pos = len(strings.Join((*batchExpr)[:eerr.TokenIndex], " "))
+		if eerr.TokenIndex > 0 {
+			pos++
+		}
+	}
+
+	if pos < 0 {
+		log.Printf("Evaluation failed [%s]: %s (stack depth %d)", eerr.Reason, eerr.Msg, eerr.StackDepth)
+		os.Exit(1)
+	}
+
+	log.Printf("Evaluation failed [%s]: %s (stack depth %d)\n\t%s\n\t%s^", eerr.Reason, eerr.Msg, eerr.StackDepth, expr, strings.Repeat(" ", pos))
+	os.Exit(1)
+}
+
 func createClient() (*calculator.Client, error) {
 	var dialOpts []grpc.DialOption
 	if *plaintext {
@@ -95,6 +310,18 @@ func createClient() (*calculator.Client, error) {
 		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)))
 	}
 
+	if strings.HasPrefix(*addr, unixScheme) {
+		path := strings.TrimPrefix(*addr, unixScheme)
+		dialOpts = append(dialOpts,
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", path)
+			}),
+			// the socket path is not a valid authority or TLS server name
+			grpc.WithAuthority("localhost"),
+		)
+	}
+
 	conn, err := grpc.Dial(*addr, dialOpts...)
 	if err != nil {
 		return nil, err
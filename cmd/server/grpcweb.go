@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+)
+
+// newGRPCWebHandler serves the grpc-web requests for the services of the gRPC server, so that they can be called from
+// browsers, and passes the other requests to next. Cross-origin requests are only accepted from the allowed origins,
+// where "*" allows any origin.
+func newGRPCWebHandler(grpcServer *grpc.Server, allowedOrigins []string, next http.Handler) http.Handler {
+	grpcHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		grpcServer.ServeHTTP(&closeNotifyWriter{ResponseWriter: w, ctx: r.Context()}, r)
+	})
+
+	wrapped := grpcweb.WrapHandler(grpcHandler,
+		grpcweb.WithOriginFunc(originMatcher(allowedOrigins)),
+		grpcweb.WithCorsForRegisteredEndpointsOnly(true),
+		grpcweb.WithEndpointsFunc(func() []string { return grpcweb.ListGRPCResources(grpcServer) }),
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrapped.IsGrpcWebRequest(r) || wrapped.IsAcceptableGrpcCorsRequest(r) {
+			wrapped.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// closeNotifyWriter adds the http.CloseNotifier interface required by grpc.Server.ServeHTTP to the response writer of
+// grpcweb, which only implements http.Flusher.
+type closeNotifyWriter struct {
+	http.ResponseWriter
+	ctx context.Context
+}
+
+func (w *closeNotifyWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *closeNotifyWriter) CloseNotify() <-chan bool {
+	closed := make(chan bool, 1)
+	go func() {
+		<-w.ctx.Done()
+		closed <- true
+	}()
+
+	return closed
+}
+
+// originMatcher reports whether cross-origin requests are allowed from an origin, ignoring case.
+func originMatcher(allowedOrigins []string) func(string) bool {
+	allowed := make(map[string]struct{}, len(allowedOrigins))
+	for _, o := range allowedOrigins {
+		if o = strings.TrimSpace(o); o != "" {
+			allowed[strings.ToLower(o)] = struct{}{}
+		}
+	}
+
+	return func(origin string) bool {
+		if _, ok := allowed["*"]; ok {
+			return true
+		}
+
+		_, ok := allowed[strings.ToLower(origin)]
+		return ok
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/charithe/calculator/pkg/calculator"
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const (
+	allowedOrigin    = "https://dashboard.example.com"
+	evaluateBatchURL = "/com.github.charithe.calculator.v1.Calculator/EvaluateBatch"
+)
+
+func TestGRPCWeb(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	v1pb.RegisterCalculatorServer(grpcServer, calculator.NewService())
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	srv := httptest.NewServer(newGRPCWebHandler(grpcServer, []string{allowedOrigin}, next))
+	defer srv.Close()
+
+	client := srv.Client()
+
+	t.Run("evaluate", func(t *testing.T) {
+		resp := postGRPCWeb(t, client, srv.URL+evaluateBatchURL, batchRequest(t, "(5 + 8) * 3"))
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, allowedOrigin, resp.Header.Get("Access-Control-Allow-Origin"))
+
+		msgs, trailers := readGRPCWebFrames(t, resp)
+		require.Len(t, msgs, 1)
+		require.Equal(t, "0", trailers["grpc-status"])
+
+		have := &v1pb.EvaluateBatchResponse{}
+		require.NoError(t, have.Unmarshal(msgs[0]))
+		require.Equal(t, float64(39), have.Result)
+	})
+
+	t.Run("evaluationError", func(t *testing.T) {
+		resp := postGRPCWeb(t, client, srv.URL+evaluateBatchURL, &v1pb.EvaluateBatchRequest{Tokens: []*v1pb.Token{{Token: &v1pb.Token_Operator{Operator: v1pb.ADD}}}})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// errors without a response message may be sent as headers only
+		msgs, trailers := readGRPCWebFrames(t, resp)
+		require.Empty(t, msgs)
+		if _, ok := trailers["grpc-status"]; !ok {
+			trailers["grpc-status"] = resp.Header.Get("Grpc-Status")
+		}
+		require.Equal(t, "3", trailers["grpc-status"])
+	})
+
+	t.Run("preflight", func(t *testing.T) {
+		testCases := []struct {
+			origin    string
+			wantAllow string
+		}{
+			{origin: allowedOrigin, wantAllow: allowedOrigin},
+			{origin: "https://elsewhere.example.com"},
+		}
+
+		for _, tc := range testCases {
+			req, err := http.NewRequest(http.MethodOptions, srv.URL+evaluateBatchURL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Origin", tc.origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, tc.wantAllow, resp.Header.Get("Access-Control-Allow-Origin"), tc.origin)
+		}
+	})
+
+	t.Run("otherRequests", func(t *testing.T) {
+		resp, err := client.Get(srv.URL + "/status")
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusTeapot, resp.StatusCode)
+	})
+}
+
+func TestOriginMatcher(t *testing.T) {
+	match := originMatcher([]string{"https://a.example.com", " HTTPS://B.example.com ", ""})
+	require.True(t, match("https://a.example.com"))
+	require.True(t, match("https://b.example.com"))
+	require.False(t, match("https://c.example.com"))
+	require.False(t, match(""))
+
+	match = originMatcher([]string{"*"})
+	require.True(t, match("https://c.example.com"))
+
+	match = originMatcher(strings.Split("", ","))
+	require.False(t, match("https://a.example.com"))
+}
+
+func batchRequest(t *testing.T, expr string) *v1pb.EvaluateBatchRequest {
+	t.Helper()
+
+	tokens, err := calculator.ParseInfix(expr)
+	require.NoError(t, err)
+	return &v1pb.EvaluateBatchRequest{Tokens: tokens}
+}
+
+func postGRPCWeb(t *testing.T, client *http.Client, url string, msg *v1pb.EvaluateBatchRequest) *http.Response {
+	t.Helper()
+
+	buf, err := msg.Marshal()
+	require.NoError(t, err)
+
+	// a grpc-web message is framed by a flags byte and the big-endian length of the message
+	frame := make([]byte, 5+len(buf))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(buf)))
+	copy(frame[5:], buf)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(frame))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("X-Grpc-Web", "1")
+	req.Header.Set("Origin", allowedOrigin)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	return resp
+}
+
+// readGRPCWebFrames returns the messages of the response and the trailers sent in the final frame.
+func readGRPCWebFrames(t *testing.T, resp *http.Response) ([][]byte, map[string]string) {
+	t.Helper()
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var msgs [][]byte
+	trailers := make(map[string]string)
+	for len(body) > 0 {
+		require.True(t, len(body) >= 5, "truncated frame header")
+		flags, size := body[0], binary.BigEndian.Uint32(body[1:5])
+		require.True(t, len(body) >= 5+int(size), "truncated frame")
+		data := body[5 : 5+size]
+		body = body[5+size:]
+
+		if flags&0x80 == 0 {
+			msgs = append(msgs, data)
+			continue
+		}
+
+		for _, line := range strings.Split(string(data), "\r\n") {
+			if kv := strings.SplitN(line, ":", 2); len(kv) == 2 {
+				trailers[strings.ToLower(strings.TrimSpace(kv[0]))] = strings.TrimSpace(kv[1])
+			}
+		}
+	}
+
+	return msgs, trailers
+}
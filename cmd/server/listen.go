@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// unixScheme is the prefix of addresses referring to a Unix domain socket, as in unix:///run/calculator.sock
+const unixScheme = "unix://"
+
+// listen creates a TCP listener or, if the address starts with unix://, a Unix domain socket listener whose file has
+// the given permissions. A socket file left behind by a process that is no longer running is removed first.
+func listen(addr string, mode os.FileMode) (net.Listener, error) {
+	if !strings.HasPrefix(addr, unixScheme) {
+		return net.Listen("tcp", addr)
+	}
+
+	path := strings.TrimPrefix(addr, unixScheme)
+	if path == "" {
+		return nil, fmt.Errorf("missing socket path in %s", addr)
+	}
+
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	return listenUnix(path, mode)
+}
+
+// removeStaleSocket removes the socket file at path if no process is accepting connections on it. Files that are not
+// sockets are never removed.
+func removeStaleSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("%s is in use by another process", path)
+	}
+
+	zap.S().Infow("Removing stale socket", "path", path)
+	return os.Remove(path)
+}
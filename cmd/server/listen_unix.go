@@ -0,0 +1,61 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// listenUnix creates a Unix domain socket listener at path whose file has the given permissions. The socket is created
+// in a private directory next to path, where no other user can connect to it, and is only moved into place once its
+// permissions have been set. Unlike restricting the umask, this does not affect files created concurrently by the rest
+// of the process.
+func listenUnix(path string, mode os.FileMode) (net.Listener, error) {
+	// the names are kept short because socket paths are limited to about a hundred bytes
+	dir, err := ioutil.TempDir(filepath.Dir(path), ".sock")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, "s")
+	listener, err := net.Listen("unix", tmpPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// the listener would remove the temporary path on close, which no longer exists once the socket has been moved
+	unixListener := listener.(*net.UnixListener)
+	unixListener.SetUnlinkOnClose(false)
+
+	if err := os.Chmod(tmpPath, mode); err != nil {
+		unixListener.Close()
+		return nil, err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		unixListener.Close()
+		return nil, err
+	}
+
+	return &socketListener{UnixListener: unixListener, path: path}, nil
+}
+
+// socketListener removes the socket file from its final path when the listener is closed.
+type socketListener struct {
+	*net.UnixListener
+	path string
+}
+
+func (l *socketListener) Close() error {
+	if err := l.UnixListener.Close(); err != nil {
+		return err
+	}
+
+	// only the first successful close removes the file, so that a socket created later at the same path is kept
+	return os.Remove(l.path)
+}
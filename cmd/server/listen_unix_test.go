@@ -0,0 +1,137 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/charithe/calculator/pkg/calculator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("tcp", func(t *testing.T) {
+		lis, err := listen("localhost:0", 0600)
+		require.NoError(t, err)
+		defer lis.Close()
+
+		require.Equal(t, "tcp", lis.Addr().Network())
+	})
+
+	t.Run("unix", func(t *testing.T) {
+		path := filepath.Join(dir, "unix.sock")
+		lis, err := listen("unix://"+path, 0600)
+		require.NoError(t, err)
+		defer lis.Close()
+
+		fi, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+		conn, err := net.Dial("unix", path)
+		require.NoError(t, err)
+		conn.Close()
+	})
+
+	t.Run("mode", func(t *testing.T) {
+		modeDir, err := ioutil.TempDir(dir, "mode")
+		require.NoError(t, err)
+
+		path := filepath.Join(modeDir, "mode.sock")
+		for _, mode := range []os.FileMode{0600, 0660, 0666} {
+			lis, err := listen("unix://"+path, mode)
+			require.NoError(t, err)
+
+			fi, err := os.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, mode, fi.Mode().Perm())
+
+			// the private directory the socket was created in is removed
+			entries, err := ioutil.ReadDir(modeDir)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+
+			// closing the listener removes the socket file
+			require.NoError(t, lis.Close())
+			_, err = os.Stat(path)
+			require.True(t, os.IsNotExist(err))
+			require.Error(t, lis.Close())
+		}
+	})
+
+	t.Run("lineProtocol", func(t *testing.T) {
+		path := filepath.Join(dir, "line.sock")
+		lis, err := listen("unix://"+path, 0600)
+		require.NoError(t, err)
+
+		lineServer := calculator.NewLineServer(calculator.NewService(), time.Second, calculator.DefaultMaxLineLength)
+		go lineServer.Serve(lis)
+		defer lineServer.Close()
+
+		conn, err := net.Dial("unix", path)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = conn.Write([]byte("5 8 + p\n"))
+		require.NoError(t, err)
+
+		have, err := bufio.NewReader(conn).ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "13\n", have)
+	})
+
+	t.Run("staleSocket", func(t *testing.T) {
+		path := filepath.Join(dir, "stale.sock")
+		stale, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		// leave the socket file behind as a crashed process would
+		stale.(*net.UnixListener).SetUnlinkOnClose(false)
+		stale.Close()
+
+		lis, err := listen("unix://"+path, 0660)
+		require.NoError(t, err)
+		defer lis.Close()
+
+		conn, err := net.Dial("unix", path)
+		require.NoError(t, err)
+		conn.Close()
+	})
+
+	t.Run("socketInUse", func(t *testing.T) {
+		path := filepath.Join(dir, "inuse.sock")
+		active, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		defer active.Close()
+
+		_, err = listen("unix://"+path, 0660)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "in use")
+	})
+
+	t.Run("notSocket", func(t *testing.T) {
+		path := filepath.Join(dir, "file")
+		require.NoError(t, ioutil.WriteFile(path, []byte("data"), 0600))
+
+		_, err := listen("unix://"+path, 0660)
+		require.Error(t, err)
+
+		_, err = os.Stat(path)
+		require.NoError(t, err)
+	})
+
+	t.Run("missingPath", func(t *testing.T) {
+		_, err := listen("unix://", 0660)
+		require.Error(t, err)
+	})
+}
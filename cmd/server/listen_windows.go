@@ -0,0 +1,12 @@
+package main
+
+import (
+	"net"
+	"os"
+)
+
+// listenUnix creates a Unix domain socket listener at path. Windows does not apply file permissions to sockets, so mode
+// is ignored.
+func listenUnix(path string, mode os.FileMode) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
@@ -11,6 +11,8 @@ import (
 	"net/http/pprof"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/charithe/calculator/pkg/calculator"
@@ -40,13 +42,27 @@ const httpTimeout = 5 * time.Second
 var (
 	app = kingpin.New("Calculator Server", "A toy RPC calculator server")
 
-	debug      = app.Flag("debug", "Enable debug mode").Envar("CALC_DEBUG").Bool()
-	listenAddr = app.Flag("listen_addr", "Listen address").Default(":8080").Envar("CALC_LISTEN_ADDR").String()
-	logLevel   = app.Flag("log_level", "Log level").Default("info").Envar("CALC_LOG_LEVEL").Enum("error", "warn", "info", "debug")
-	statusAddr = app.Flag("status_addr", "Status address").Default(":5000").Envar("CALC_STATUS_ADDR").String()
-	tlsCA      = app.Flag("tls_ca", "Path to TLS CA certificate").Envar("CALC_TLS_CA").ExistingFile()
-	tlsCert    = app.Flag("tls_cert", "Path to TLS certificate").Envar("CALC_TLS_CERT").ExistingFile()
-	tlsKey     = app.Flag("tls_key", "Path to TLS key").Envar("CALC_TLS_KEY").ExistingFile()
+	origins     = app.Flag("allowed_origins", "Comma separated list of origins allowed to make cross-origin requests from browsers (* for any)").Envar("CALC_ALLOWED_ORIGINS").String()
+	debug       = app.Flag("debug", "Enable debug mode").Envar("CALC_DEBUG").Bool()
+	grpcWeb     = app.Flag("grpc_web", "Serve grpc-web requests on the status address").Envar("CALC_GRPC_WEB").Bool()
+	historyFile = app.Flag("history_file", "Path to the file recording the evaluation history (disabled if empty)").Envar("CALC_HISTORY_FILE").String()
+	historyTTL  = app.Flag("history_retention", "Time after which history entries are removed (0 to keep them)").Default("720h").Envar("CALC_HISTORY_RETENTION").Duration()
+	lineAddr    = app.Flag("line_addr", "Listen address of the plain-text line protocol (disabled if empty, unix:///path for a Unix domain socket)").Envar("CALC_LINE_ADDR").String()
+	lineIdle    = app.Flag("line_idle_timeout", "Time after which idle line protocol connections are closed (0 to keep them)").Default(calculator.DefaultLineIdleTimeout.String()).Envar("CALC_LINE_IDLE_TIMEOUT").Duration()
+	lineMaxLen  = app.Flag("line_max_length", "Maximum length of a line protocol request in bytes (0 for unlimited)").Default(strconv.Itoa(calculator.DefaultMaxLineLength)).Envar("CALC_LINE_MAX_LENGTH").Uint()
+	listenAddr  = app.Flag("listen_addr", "Listen address (unix:///path for a Unix domain socket)").Default(":8080").Envar("CALC_LISTEN_ADDR").String()
+	logLevel    = app.Flag("log_level", "Log level").Default("info").Envar("CALC_LOG_LEVEL").Enum("error", "warn", "info", "debug")
+	maxMsgSize  = app.Flag("max_message_size", "Maximum size of a gRPC message or HTTP request body").Default("4MiB").Envar("CALC_MAX_MESSAGE_SIZE").Bytes()
+	maxSessions = app.Flag("max_sessions", "Maximum number of sessions across all clients (0 for unlimited)").Default(strconv.Itoa(calculator.DefaultMaxSessions)).Envar("CALC_MAX_SESSIONS").Uint()
+	maxPerIP    = app.Flag("max_sessions_per_client", "Maximum number of sessions per client address (0 for unlimited)").Default(strconv.Itoa(calculator.DefaultMaxSessionsPerClient)).Envar("CALC_MAX_SESSIONS_PER_CLIENT").Uint()
+	maxStack    = app.Flag("max_stack_depth", "Maximum evaluator stack depth (0 for unlimited)").Default(strconv.Itoa(calculator.DefaultMaxStackDepth)).Envar("CALC_MAX_STACK_DEPTH").Uint()
+	cacheSize   = app.Flag("program_cache_size", "Number of compiled programs to keep").Default(strconv.Itoa(calculator.DefaultProgramCacheSize)).Envar("CALC_PROGRAM_CACHE_SIZE").Int()
+	sessionTTL  = app.Flag("session_ttl", "Time after which idle sessions are removed (0 to keep them)").Default(calculator.DefaultSessionTTL.String()).Envar("CALC_SESSION_TTL").Duration()
+	sockMode    = app.Flag("socket_mode", "Permissions of Unix domain sockets in octal").Default("0660").Envar("CALC_SOCKET_MODE").String()
+	statusAddr  = app.Flag("status_addr", "Status address (unix:///path for a Unix domain socket)").Default(":5000").Envar("CALC_STATUS_ADDR").String()
+	tlsCA       = app.Flag("tls_ca", "Path to TLS CA certificate").Envar("CALC_TLS_CA").ExistingFile()
+	tlsCert     = app.Flag("tls_cert", "Path to TLS certificate").Envar("CALC_TLS_CERT").ExistingFile()
+	tlsKey      = app.Flag("tls_key", "Path to TLS key").Envar("CALC_TLS_KEY").ExistingFile()
 )
 
 func main() {
@@ -124,8 +140,31 @@ func startServer() {
 	}
 
 	grpcListener, httpListener := startListeners()
-	grpcServer := startGRPCServer(grpcListener, calculator.NewService())
-	statusServer := startHTTPServer(httpListener, promExporter)
+	opts := []calculator.Option{
+		calculator.WithMaxStackDepth(int(*maxStack)),
+		calculator.WithProgramCacheSize(*cacheSize),
+		calculator.WithSessionTTL(*sessionTTL),
+		calculator.WithMaxSessions(int(*maxSessions)),
+		calculator.WithMaxSessionsPerClient(int(*maxPerIP)),
+	}
+
+	var history *calculator.HistoryStore
+	if *historyFile != "" {
+		history, err = calculator.OpenHistoryStore(*historyFile, *historyTTL)
+		if err != nil {
+			zap.S().Fatalw("Failed to open history file", "path", *historyFile, "error", err)
+		}
+		opts = append(opts, calculator.WithHistory(history))
+	}
+
+	svc := calculator.NewService(opts...)
+	grpcServer := startGRPCServer(grpcListener, svc)
+	statusServer := startHTTPServer(httpListener, promExporter, svc, grpcServer)
+
+	var lineServer *calculator.LineServer
+	if *lineAddr != "" {
+		lineServer = startLineServer(svc)
+	}
 
 	// await interruption
 	shutdownChan := make(chan os.Signal, 1)
@@ -138,6 +177,19 @@ func startServer() {
 	ctx, cancelFunc := context.WithTimeout(context.Background(), httpTimeout)
 	defer cancelFunc()
 	statusServer.Shutdown(ctx)
+
+	if lineServer != nil {
+		lineServer.Close()
+	}
+
+	svc.Close()
+
+	// the history is closed last as the evaluations served by any of the servers are recorded in it
+	if history != nil {
+		if err := history.Close(); err != nil {
+			zap.S().Warnw("Failed to close history file", "error", err)
+		}
+	}
 }
 
 func initOCPromExporter() (*prometheus.Exporter, error) {
@@ -145,6 +197,10 @@ func initOCPromExporter() (*prometheus.Exporter, error) {
 		return nil, err
 	}
 
+	if err := view.Register(calculator.ProgramCacheViews...); err != nil {
+		return nil, err
+	}
+
 	registry, ok := prom.DefaultRegisterer.(*prom.Registry)
 	if !ok {
 		zap.S().Warn("Unable to obtain default Prometheus registry. Creating new one.")
@@ -163,7 +219,7 @@ func initOCPromExporter() (*prometheus.Exporter, error) {
 }
 
 func startListeners() (net.Listener, net.Listener) {
-	grpcListener, err := net.Listen("tcp", *listenAddr)
+	grpcListener, err := listen(*listenAddr, socketMode())
 	if err != nil {
 		zap.S().Fatalw("Failed to create grpc listener", "error", err)
 	}
@@ -178,14 +234,23 @@ func startListeners() (net.Listener, net.Listener) {
 		grpcListener = tls.NewListener(grpcListener, tlsConf)
 	}
 
-	httpListener, err := net.Listen("tcp", *statusAddr)
+	httpListener, err := listen(*statusAddr, socketMode())
 	if err != nil {
-		zap.S().Fatalw("Failed to create http listener")
+		zap.S().Fatalw("Failed to create http listener", "error", err)
 	}
 
 	return grpcListener, httpListener
 }
 
+func socketMode() os.FileMode {
+	mode, err := strconv.ParseUint(*sockMode, 8, 32)
+	if err != nil || mode > 0777 {
+		zap.S().Fatalw("Invalid socket mode", "mode", *sockMode)
+	}
+
+	return os.FileMode(mode)
+}
+
 func getTLSConfig() (*tls.Config, error) {
 	certificate, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
 	if err != nil {
@@ -248,6 +313,9 @@ func startGRPCServer(listener net.Listener, svc *calculator.Service) *grpc.Serve
 
 	serverOpts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+		// the limit applies to every RPC so it should only be raised for deployments evaluating large EvaluateVector tables
+		grpc.MaxRecvMsgSize(int(*maxMsgSize)),
+		grpc.MaxSendMsgSize(int(*maxMsgSize)),
 		grpc_middleware.WithUnaryServerChain(
 			grpc_ctxtags.UnaryServerInterceptor(),
 			grpc_zap.UnaryServerInterceptor(grpcLogger, grpc_zap.WithLevels(codeToLevel)),
@@ -276,7 +344,7 @@ func startGRPCServer(listener net.Listener, svc *calculator.Service) *grpc.Serve
 	return grpcServer
 }
 
-func startHTTPServer(listener net.Listener, promExporter *prometheus.Exporter) *http.Server {
+func startHTTPServer(listener net.Listener, promExporter *prometheus.Exporter, svc *calculator.Service, grpcServer *grpc.Server) *http.Server {
 	logger := zap.L().Named("http")
 
 	mux := http.NewServeMux()
@@ -289,6 +357,10 @@ func startHTTPServer(listener net.Listener, promExporter *prometheus.Exporter) *
 		io.WriteString(w, "OK")
 	})
 	mux.Handle("/metrics", promExporter)
+	// JSON endpoints for clients that cannot use gRPC
+	mux.Handle("/v1/", calculator.NewHTTPHandler(svc, int64(*maxMsgSize)))
+	mux.Handle("/v1/interactive", calculator.NewWebSocketHandler(svc, originMatcher(strings.Split(*origins, ","))))
+	mux.Handle("/jsonrpc", calculator.NewJSONRPCHandler(svc, int64(*maxMsgSize)))
 
 	if *debug {
 		mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
@@ -299,8 +371,14 @@ func startHTTPServer(listener net.Listener, promExporter *prometheus.Exporter) *
 		mux.Handle("/debug/", http.StripPrefix("/debug", zpages.Handler))
 	}
 
+	var handler http.Handler = mux
+	if *grpcWeb {
+		zap.S().Infow("Enabling grpc-web", "origins", *origins)
+		handler = newGRPCWebHandler(grpcServer, strings.Split(*origins, ","), mux)
+	}
+
 	httpServer := &http.Server{
-		Handler:           mux,
+		Handler:           handler,
 		ErrorLog:          zap.NewStdLog(logger),
 		ReadHeaderTimeout: httpTimeout,
 		WriteTimeout:      httpTimeout,
@@ -316,3 +394,20 @@ func startHTTPServer(listener net.Listener, promExporter *prometheus.Exporter) *
 
 	return httpServer
 }
+
+func startLineServer(svc *calculator.Service) *calculator.LineServer {
+	listener, err := listen(*lineAddr, socketMode())
+	if err != nil {
+		zap.S().Fatalw("Failed to create line protocol listener", "error", err)
+	}
+
+	lineServer := calculator.NewLineServer(svc, *lineIdle, int(*lineMaxLen))
+	go func() {
+		zap.S().Infow("Starting line protocol server", "addr", *lineAddr)
+		if err := lineServer.Serve(listener); err != nil {
+			zap.S().Fatalw("Line protocol server failed", "error", err)
+		}
+	}()
+
+	return lineServer
+}
@@ -1,19 +1,25 @@
 module github.com/charithe/calculator
 
 require (
+	github.com/desertbit/timer v0.0.0-20180107155436-c41aec40b27f // indirect
 	github.com/gogo/protobuf v1.2.1
-	github.com/golang/protobuf v1.3.0 // indirect
+	github.com/golang/protobuf v1.3.0
+	github.com/gorilla/websocket v1.4.2 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware v1.0.0
+	github.com/hashicorp/golang-lru v0.5.0
+	github.com/improbable-eng/grpc-web v0.13.0
 	github.com/mattn/go-isatty v0.0.7
 	github.com/pkg/errors v0.8.1
 	github.com/prometheus/client_golang v0.9.3-0.20190127221311-3c4408c8b829
+	github.com/rs/cors v1.7.0 // indirect
 	github.com/sirupsen/logrus v1.4.0 // indirect
 	github.com/stretchr/testify v1.3.0
 	go.opencensus.io v0.19.1
 	go.uber.org/atomic v1.3.2 // indirect
 	go.uber.org/multierr v1.1.0 // indirect
 	go.uber.org/zap v1.9.1
-	golang.org/x/net v0.0.0-20190318221613-d196dffd7c2b // indirect
+	golang.org/x/net v0.0.0-20190318221613-d196dffd7c2b
+	google.golang.org/genproto v0.0.0-20181219182458-5a97ab628bfb
 	google.golang.org/grpc v1.19.0
 	gopkg.in/alecthomas/kingpin.v2 v2.2.6
 )
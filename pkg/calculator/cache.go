@@ -0,0 +1,59 @@
+package calculator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	lru "github.com/hashicorp/golang-lru"
+	"go.opencensus.io/stats"
+)
+
+// DefaultProgramCacheSize is the number of compiled programs kept when the service is created without
+// WithProgramCacheSize.
+const DefaultProgramCacheSize = 1024
+
+// programCache holds compiled programs by ID and evicts the least recently used ones when full.
+// This is safe for concurrent use.
+type programCache struct {
+	cache *lru.Cache
+}
+
+func newProgramCache(size int) (*programCache, error) {
+	cache, err := lru.NewWithEvict(size, func(_, _ interface{}) {
+		stats.Record(context.Background(), programCacheEvictions.M(1))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &programCache{cache: cache}, nil
+}
+
+// add stores the program and returns its ID. The ID is derived from the tokens so compiling the same tokens again
+// returns the same ID.
+func (c *programCache) add(ctx context.Context, prog *program) (string, error) {
+	buf, err := (&v1pb.CompileRequest{Tokens: prog.tokens}).Marshal()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(buf)
+	id := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	c.cache.Add(id, prog)
+	stats.Record(ctx, programCacheEntries.M(int64(c.cache.Len())))
+	return id, nil
+}
+
+func (c *programCache) get(ctx context.Context, id string) (*program, bool) {
+	v, ok := c.cache.Get(id)
+	if !ok {
+		stats.Record(ctx, programCacheMisses.M(1))
+		return nil, false
+	}
+
+	stats.Record(ctx, programCacheHits.M(1))
+	return v.(*program), true
+}
@@ -0,0 +1,68 @@
+package calculator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+)
+
+func TestProgramCache(t *testing.T) {
+	require.NoError(t, view.Register(ProgramCacheViews...))
+	defer view.Unregister(ProgramCacheViews...)
+
+	ctx := context.Background()
+	cache, err := newProgramCache(2)
+	require.NoError(t, err)
+
+	compileTokens := func(tokenStrs ...string) *program {
+		tokens, err := parseTokens(tokenStrs)
+		require.NoError(t, err)
+
+		prog, err := compile(tokens, 0)
+		require.NoError(t, err)
+		return prog
+	}
+
+	id1, err := cache.add(ctx, compileTokens("1", "2", "+"))
+	require.NoError(t, err)
+
+	// the ID only depends on the tokens
+	sameID, err := cache.add(ctx, compileTokens("1", "2", "+"))
+	require.NoError(t, err)
+	require.Equal(t, id1, sameID)
+
+	id2, err := cache.add(ctx, compileTokens("3", "4", "+"))
+	require.NoError(t, err)
+	require.NotEqual(t, id1, id2)
+
+	// using the first program makes the second one the least recently used
+	_, ok := cache.get(ctx, id1)
+	require.True(t, ok)
+
+	id3, err := cache.add(ctx, compileTokens("5", "6", "+"))
+	require.NoError(t, err)
+
+	_, ok = cache.get(ctx, id2)
+	require.False(t, ok)
+
+	for _, id := range []string{id1, id3} {
+		prog, ok := cache.get(ctx, id)
+		require.True(t, ok)
+		require.Len(t, prog.tokens, 3)
+	}
+
+	require.Equal(t, int64(3), countMetric(t, "calculator/program_cache/hits"))
+	require.Equal(t, int64(1), countMetric(t, "calculator/program_cache/misses"))
+	require.Equal(t, int64(1), countMetric(t, "calculator/program_cache/evictions"))
+}
+
+func countMetric(t *testing.T, name string) int64 {
+	t.Helper()
+
+	rows, err := view.RetrieveData(name)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	return rows[0].Data.(*view.CountData).Value
+}
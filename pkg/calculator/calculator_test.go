@@ -2,12 +2,20 @@ package calculator
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/charithe/calculator/pkg/v1pb"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestCalculator(t *testing.T) {
@@ -29,6 +37,16 @@ func TestCalculator(t *testing.T) {
 			tokens:     []string{"5", "8", " + ", " 3 ", "-", "2", "/", "5", "*"},
 			wantResult: 25,
 		},
+		{
+			name:       "functions",
+			tokens:     []string{"16", "sqrt", "2", "10", "max:3", "NEG"},
+			wantResult: -10,
+		},
+		{
+			name:       "stackOps",
+			tokens:     []string{"2", "3", "swap", "dup", "*", "5", "rot", "drop", "-"},
+			wantResult: -1,
+		},
 		{
 			name:    "invalidTokens",
 			tokens:  []string{"5", "a", "+"},
@@ -88,6 +106,930 @@ func TestCalculator(t *testing.T) {
 	})
 }
 
+func TestEvaluateBatchDecimal(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	haveResult, err := client.EvaluateBatchDecimal(context.Background(), []string{"0.1", "0.2", "+"}, v1pb.EXACT, 0)
+	require.NoError(t, err)
+	require.Equal(t, "0.3", haveResult)
+
+	haveResult, err = client.EvaluateInfixDecimal(context.Background(), "2 ^ 70 + 1", v1pb.BIG_FLOAT, 0)
+	require.NoError(t, err)
+	require.Equal(t, "1180591620717411303425", haveResult)
+
+	_, err = client.EvaluateBatchDecimal(context.Background(), []string{"1"}, v1pb.BIG_FLOAT, maxFloatPrecision+1)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMaxStackDepth(t *testing.T) {
+	svc := NewService(WithMaxStackDepth(4))
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	testCases := []struct {
+		name      string
+		tokens    []string
+		requested uint32
+		wantLimit uint32
+	}{
+		{name: "withinServerLimit", tokens: []string{"1", "2", "3", "4", "+", "+", "+"}},
+		{name: "exceedsServerLimit", tokens: []string{"1", "2", "3", "4", "5", "+", "+", "+", "+"}, wantLimit: 4},
+		{name: "exceedsRequestedLimit", tokens: []string{"1", "2", "3", "+", "+"}, requested: 2, wantLimit: 2},
+		{name: "cannotRaiseLimit", tokens: []string{"1", "2", "3", "4", "5", "+", "+", "+", "+"}, requested: 10, wantLimit: 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := parseTokens(tc.tokens)
+			require.NoError(t, err)
+
+			_, err = client.client.EvaluateBatch(context.Background(), &v1pb.EvaluateBatchRequest{
+				Tokens:        tokens,
+				MaxStackDepth: tc.requested,
+			})
+			if tc.wantLimit == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			st := status.Convert(err)
+			require.Equal(t, codes.ResourceExhausted, st.Code())
+			require.Contains(t, st.Details(), &v1pb.StackDepthExceeded{Limit: tc.wantLimit})
+		})
+	}
+}
+
+func TestEvaluationErrors(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	testCases := []struct {
+		name      string
+		tokens    []string
+		wantCode  codes.Code
+		wantError *v1pb.EvaluationError
+	}{
+		{
+			name:      "notEnoughOperands",
+			tokens:    []string{"1", "2", "+", "+"},
+			wantCode:  codes.InvalidArgument,
+			wantError: &v1pb.EvaluationError{Reason: v1pb.NOT_ENOUGH_OPERANDS, TokenIndex: 3, Token: "+", StackDepth: 1},
+		},
+		{
+			name:      "divisionByZero",
+			tokens:    []string{"4", "1", "1", "-", "%"},
+			wantCode:  codes.InvalidArgument,
+			wantError: &v1pb.EvaluationError{Reason: v1pb.DIVISION_BY_ZERO, TokenIndex: 4, Token: "%", StackDepth: 2},
+		},
+		{
+			name:      "domainError",
+			tokens:    []string{"-1", "sqrt"},
+			wantCode:  codes.InvalidArgument,
+			wantError: &v1pb.EvaluationError{Reason: v1pb.DOMAIN_ERROR, TokenIndex: 1, Token: "sqrt", StackDepth: 1},
+		},
+		{
+			name:      "wrongArity",
+			tokens:    []string{"1", "2", "sqrt:2"},
+			wantCode:  codes.InvalidArgument,
+			wantError: &v1pb.EvaluationError{Reason: v1pb.WRONG_ARITY, TokenIndex: 2, Token: "sqrt:2", StackDepth: 2},
+		},
+		{
+			name:      "incompleteExpression",
+			tokens:    []string{"1", "2"},
+			wantCode:  codes.InvalidArgument,
+			wantError: &v1pb.EvaluationError{Reason: v1pb.INCOMPLETE_EXPRESSION, TokenIndex: -1, StackDepth: 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := parseTokens(tc.tokens)
+			require.NoError(t, err)
+
+			_, err = client.client.EvaluateBatch(context.Background(), &v1pb.EvaluateBatchRequest{Tokens: tokens})
+			st := status.Convert(err)
+			require.Equal(t, tc.wantCode, st.Code())
+			require.Contains(t, st.Details(), tc.wantError)
+
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, d := range st.Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					violations = br.FieldViolations
+				}
+			}
+
+			if tc.wantError.TokenIndex < 0 {
+				require.Empty(t, violations)
+				return
+			}
+
+			require.Len(t, violations, 1)
+			require.Equal(t, fmt.Sprintf("tokens[%d]", tc.wantError.TokenIndex), violations[0].Field)
+		})
+	}
+}
+
+func TestClientEvaluationError(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	t.Run("batch", func(t *testing.T) {
+		_, err := client.EvaluateBatch(context.Background(), []string{"1", "0", "//"})
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, v1pb.DIVISION_BY_ZERO, eerr.Reason)
+		require.Equal(t, 2, eerr.TokenIndex)
+		require.Equal(t, "//", eerr.Token)
+		require.Equal(t, -1, eerr.Pos)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("infix", func(t *testing.T) {
+		_, err := client.EvaluateInfix(context.Background(), "2 * (3 % (1 - 1))")
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, v1pb.DIVISION_BY_ZERO, eerr.Reason)
+		require.Equal(t, 7, eerr.Pos)
+	})
+
+	t.Run("expression", func(t *testing.T) {
+		_, err := client.EvaluateExpression(context.Background(), "1 2  sqrt:2", v1pb.RPN)
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, v1pb.WRONG_ARITY, eerr.Reason)
+		require.Equal(t, 5, eerr.Pos)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		tokens := make(chan string, 2)
+		tokens <- "1"
+		tokens <- "+"
+		close(tokens)
+
+		_, err := client.EvaluateStream(tokens)
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, v1pb.NOT_ENOUGH_OPERANDS, eerr.Reason)
+		require.Equal(t, 1, eerr.TokenIndex)
+	})
+}
+
+func TestEvaluateExpression(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	testCases := []struct {
+		name       string
+		expr       string
+		notation   v1pb.Notation
+		wantResult float64
+		wantErr    bool
+		wantPos    int
+	}{
+		{
+			name:       "rpn",
+			expr:       "5 8 +  3 - 2 / 5 *",
+			notation:   v1pb.RPN,
+			wantResult: 25,
+		},
+		{
+			name:       "infix",
+			expr:       "(5 + 8) * 3 - 2",
+			notation:   v1pb.INFIX,
+			wantResult: 37,
+		},
+		{
+			name:       "rpnUnicodeSpace",
+			expr:       "5\u00a08\u2003+",
+			notation:   v1pb.RPN,
+			wantResult: 13,
+		},
+		{
+			name:     "rpnInvalidToken",
+			expr:     "5 1.2.3 +",
+			notation: v1pb.RPN,
+			wantErr:  true,
+			wantPos:  2,
+		},
+		{
+			name:     "rpnInvalidUTF8",
+			expr:     "5 8\xc3 +",
+			notation: v1pb.RPN,
+			wantErr:  true,
+			wantPos:  2,
+		},
+		{
+			name:     "infixSyntaxError",
+			expr:     "(5 + 8 * 3",
+			notation: v1pb.INFIX,
+			wantErr:  true,
+			wantPos:  10,
+		},
+		{
+			name:     "evaluationError",
+			expr:     "5 +",
+			notation: v1pb.RPN,
+			wantErr:  true,
+			wantPos:  -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			haveResult, err := client.EvaluateExpression(context.Background(), tc.expr, tc.notation)
+			if !tc.wantErr {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantResult, haveResult)
+				return
+			}
+
+			require.Error(t, err)
+			perr, ok := err.(*ParseError)
+			if tc.wantPos < 0 {
+				require.False(t, ok)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+
+			require.True(t, ok, "expected *ParseError, got %T", err)
+			require.Equal(t, tc.wantPos, perr.Pos)
+		})
+	}
+}
+
+func TestEvaluateInteractive(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	stream, err := client.EvaluateInteractive(context.Background())
+	require.NoError(t, err)
+
+	steps := []struct {
+		token     string
+		wantTop   float64
+		wantDepth int32
+		wantErr   bool
+	}{
+		{token: "5", wantTop: 5, wantDepth: 1},
+		{token: "+", wantTop: 5, wantDepth: 1, wantErr: true},
+		{token: "8", wantTop: 8, wantDepth: 2},
+		{token: "+", wantTop: 13, wantDepth: 1},
+		{token: "3", wantTop: 3, wantDepth: 2},
+		{token: "*", wantTop: 39, wantDepth: 1},
+	}
+
+	for _, step := range steps {
+		resp, err := stream.Push(step.token)
+		require.NoError(t, err)
+		require.Equal(t, step.wantTop, resp.StackTop)
+		require.Equal(t, step.wantDepth, resp.StackDepth)
+		if step.wantErr {
+			require.NotEmpty(t, resp.Error)
+		} else {
+			require.Empty(t, resp.Error)
+		}
+	}
+
+	// tokenizer errors are reported locally without affecting the stream
+	_, err = stream.Push("1.2.3")
+	require.Error(t, err)
+
+	resp, err := stream.Push("1")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.StackDepth)
+
+	require.NoError(t, stream.Close())
+}
+
+func TestBindings(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	t.Run("batch", func(t *testing.T) {
+		haveResult, err := client.EvaluateBatchWithBindings(context.Background(), []string{"x", "2", "*", "y", "+"}, map[string]float64{"x": 3, "y": 4})
+		require.NoError(t, err)
+		require.Equal(t, float64(10), haveResult)
+	})
+
+	t.Run("exact", func(t *testing.T) {
+		tokens, err := parseTokens([]string{"x", "x", "+", "x", "+"})
+		require.NoError(t, err)
+
+		resp, err := client.client.EvaluateBatch(context.Background(), &v1pb.EvaluateBatchRequest{
+			Tokens:    tokens,
+			Precision: v1pb.EXACT,
+			Bindings:  map[string]*v1pb.Operand{"x": {Decimal: "0.1"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "0.3", resp.ResultDecimal)
+	})
+
+	t.Run("infix", func(t *testing.T) {
+		resp, err := client.client.EvaluateExpression(context.Background(), &v1pb.EvaluateExpressionRequest{
+			Expression: "2 * rate + max(rate, 1)",
+			Notation:   v1pb.INFIX,
+			Bindings:   map[string]*v1pb.Operand{"rate": {Value: 1.5}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4.5, resp.Result)
+	})
+
+	t.Run("nonFiniteNames", func(t *testing.T) {
+		haveResult, err := client.EvaluateBatchWithBindings(context.Background(), []string{"inf", "NaN", "+"}, map[string]float64{"inf": 1, "NaN": 2})
+		require.NoError(t, err)
+		require.Equal(t, float64(3), haveResult)
+
+		_, err = client.EvaluateBatchWithBindings(context.Background(), []string{"inf"}, nil)
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, v1pb.UNBOUND_VARIABLE, eerr.Reason)
+
+		for _, tokStr := range []string{"+inf", "-Infinity", "1e400"} {
+			_, err := parseToken(tokStr)
+			require.Error(t, err, tokStr)
+		}
+	})
+
+	t.Run("unbound", func(t *testing.T) {
+		_, err := client.EvaluateBatchWithBindings(context.Background(), []string{"x", "y", "+", "z", "*", "y", "-"}, map[string]float64{"x": 1})
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, v1pb.UNBOUND_VARIABLE, eerr.Reason)
+		require.Equal(t, 1, eerr.TokenIndex)
+		require.Equal(t, "unbound variables: y, z", eerr.Msg)
+	})
+}
+
+func TestClientEvaluateVector(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	resp, err := client.EvaluateVector(context.Background(), []string{"price", "qty", "*"}, map[string][]float64{
+		"price": {1.5, 2, 10},
+		"qty":   {2, 3, 0.5},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []float64{3, 6, 5}, resp.Results)
+	require.Empty(t, resp.Errors)
+
+	_, err = client.EvaluateVector(context.Background(), []string{"price", "qty", "*"}, map[string][]float64{"price": {1}})
+	eerr, ok := err.(*EvaluationError)
+	require.True(t, ok, "expected *EvaluationError, got %T", err)
+	require.Equal(t, v1pb.UNBOUND_VARIABLE, eerr.Reason)
+}
+
+func TestCompileAndExecute(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	id, err := client.Compile(context.Background(), []string{"x", "2", "*", "y", "+"})
+	require.NoError(t, err)
+
+	for _, x := range []float64{1, 2, 3} {
+		haveResult, err := client.Execute(context.Background(), id, map[string]float64{"x": x, "y": 10})
+		require.NoError(t, err)
+		require.Equal(t, x*2+10, haveResult)
+	}
+
+	_, err = client.Execute(context.Background(), id, map[string]float64{"x": 1})
+	eerr, ok := err.(*EvaluationError)
+	require.True(t, ok, "expected *EvaluationError, got %T", err)
+	require.Equal(t, v1pb.UNBOUND_VARIABLE, eerr.Reason)
+
+	_, err = client.Compile(context.Background(), []string{"1", "+"})
+	eerr, ok = err.(*EvaluationError)
+	require.True(t, ok, "expected *EvaluationError, got %T", err)
+	require.Equal(t, v1pb.NOT_ENOUGH_OPERANDS, eerr.Reason)
+	require.Equal(t, 1, eerr.TokenIndex)
+
+	_, err = client.Execute(context.Background(), "unknown", nil)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestValidate(t *testing.T) {
+	svc := NewService(WithMaxStackDepth(3))
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	type problem struct {
+		reason   v1pb.ErrorReason
+		index    int32
+		position int32
+	}
+
+	testCases := []struct {
+		name         string
+		expr         string
+		notation     v1pb.Notation
+		wantDepth    uint32
+		wantProblems []problem
+	}{
+		{
+			name:      "valid",
+			expr:      "5 8 + 3 * x -",
+			notation:  v1pb.RPN,
+			wantDepth: 2,
+		},
+		{
+			name:      "infixOverflow",
+			expr:      "(5 + 8) * max(3, y, 1)",
+			notation:  v1pb.INFIX,
+			wantDepth: 4,
+			wantProblems: []problem{
+				{reason: v1pb.STACK_FULL, index: 5, position: 20},
+			},
+		},
+		{
+			name:      "allProblems",
+			expr:      "+ 1 2 3 4 1.2.3 sqrt:2 5",
+			notation:  v1pb.RPN,
+			wantDepth: 6,
+			wantProblems: []problem{
+				{reason: v1pb.NOT_ENOUGH_OPERANDS, index: 0, position: 0},
+				{reason: v1pb.STACK_FULL, index: 3, position: 6},
+				{reason: v1pb.UNKNOWN_OPERATION, index: 5, position: 10},
+				{reason: v1pb.WRONG_ARITY, index: 6, position: 16},
+				{reason: v1pb.INCOMPLETE_EXPRESSION, index: -1, position: -1},
+			},
+		},
+		{
+			name:     "syntaxError",
+			expr:     "(1 + 2",
+			notation: v1pb.INFIX,
+			wantProblems: []problem{
+				{reason: v1pb.SYNTAX_ERROR, index: -1, position: 6},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := client.Validate(context.Background(), tc.expr, tc.notation)
+			require.NoError(t, err)
+			require.Equal(t, len(tc.wantProblems) == 0, resp.Valid)
+			require.Equal(t, tc.wantDepth, resp.MaxStackDepth)
+
+			var haveProblems []problem
+			for _, p := range resp.Problems {
+				require.NotEmpty(t, p.Message)
+				haveProblems = append(haveProblems, problem{reason: p.Error.Reason, index: p.Error.TokenIndex, position: p.Position})
+			}
+			require.Equal(t, tc.wantProblems, haveProblems)
+		})
+	}
+}
+
+func TestTokenStream(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	t.Run("result", func(t *testing.T) {
+		stream, err := client.OpenStream(context.Background())
+		require.NoError(t, err)
+		defer stream.Close()
+
+		require.NoError(t, stream.Send("5"))
+		require.Error(t, stream.Send("5.5.5"))
+		require.NoError(t, stream.Send("8"))
+		require.NoError(t, stream.Send("+"))
+
+		resp, err := stream.Result()
+		require.NoError(t, err)
+		require.Equal(t, float64(13), resp.Result)
+	})
+
+	t.Run("rejectedToken", func(t *testing.T) {
+		stream, err := client.OpenStream(context.Background())
+		require.NoError(t, err)
+		defer stream.Close()
+
+		require.NoError(t, stream.Send("5"))
+		require.NoError(t, stream.Send("+"))
+
+		_, err = stream.Result()
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, 1, eerr.TokenIndex)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := client.OpenStream(ctx)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		require.NoError(t, stream.Send("5"))
+		cancel()
+
+		_, err = stream.Result()
+		require.Equal(t, codes.Canceled, status.Code(err))
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// the channel is never closed so the call can only end by the deadline
+		tokens := make(chan string, 1)
+		tokens <- "5"
+
+		_, err := client.EvaluateStreamContext(ctx, tokens)
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+
+	t.Run("invalidToken", func(t *testing.T) {
+		tokens := make(chan string, 2)
+		tokens <- "5"
+		tokens <- "5.5.5"
+
+		_, err := client.EvaluateStreamContext(context.Background(), tokens)
+		require.Error(t, err)
+		require.Equal(t, codes.Unknown, status.Code(err))
+	})
+}
+
+func TestExplain(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	t.Run("batch", func(t *testing.T) {
+		resp, err := client.Explain(context.Background(), "5 8 dup * +", v1pb.RPN, v1pb.FLOAT64, 0)
+		require.NoError(t, err)
+		require.Equal(t, float64(69), resp.Result)
+		require.Equal(t, &v1pb.Trace{
+			Steps: []*v1pb.TraceStep{
+				{Token: "5", Stack: []string{"5"}},
+				{Token: "8", Stack: []string{"5", "8"}},
+				{Token: "dup", Stack: []string{"5", "8", "8"}},
+				{Token: "*", Stack: []string{"5", "64"}},
+				{Token: "+", Stack: []string{"69"}},
+			},
+		}, resp.Trace)
+	})
+
+	t.Run("exact", func(t *testing.T) {
+		resp, err := client.Explain(context.Background(), "1 / 3 + 1", v1pb.INFIX, v1pb.EXACT, 0)
+		require.NoError(t, err)
+		require.Len(t, resp.Trace.Steps, 5)
+		require.Equal(t, &v1pb.TraceStep{Token: "+", Stack: []string{"1.3333333333333333333333333333333333333333"}}, resp.Trace.Steps[4])
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := client.Explain(context.Background(), "4 2 sqrt -1 sqrt", v1pb.RPN, v1pb.FLOAT64, 0)
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, v1pb.DOMAIN_ERROR, eerr.Reason)
+		require.Equal(t, 12, eerr.Pos)
+		require.Len(t, eerr.Trace.GetSteps(), 4)
+		require.Equal(t, []string{"4", "1.4142135623730951", "-1"}, eerr.Trace.Steps[3].Stack)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		tokens, err := parseTokens([]string{"1", "2", "+"})
+		require.NoError(t, err)
+
+		resp, err := client.client.EvaluateBatch(context.Background(), &v1pb.EvaluateBatchRequest{Tokens: tokens})
+		require.NoError(t, err)
+		require.Nil(t, resp.Trace)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		tokens, err := parseTokens([]string{"2", "3", "^"})
+		require.NoError(t, err)
+
+		stream, err := client.client.EvaluateStream(context.Background())
+		require.NoError(t, err)
+
+		for i, tok := range tokens {
+			require.NoError(t, stream.Send(&v1pb.EvaluateStreamRequest{Token: tok, Explain: i == 0}))
+		}
+
+		resp, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, []*v1pb.TraceStep{
+			{Token: "2", Stack: []string{"2"}},
+			{Token: "3", Stack: []string{"2", "3"}},
+			{Token: "^", Stack: []string{"8"}},
+		}, resp.Trace.GetSteps())
+	})
+}
+
+func TestConvert(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	testCases := []struct {
+		name      string
+		expr      string
+		notation  v1pb.Notation
+		target    v1pb.Notation
+		wantExpr  string
+		wantError error
+	}{
+		{
+			name:     "rpnToInfix",
+			expr:     "5 8 + 3 * 2 -",
+			notation: v1pb.RPN,
+			target:   v1pb.INFIX,
+			wantExpr: "(5 + 8) * 3 - 2",
+		},
+		{
+			name:     "infixToRPN",
+			expr:     "-(x + 1) ^ 2 / max(1, 2)",
+			notation: v1pb.INFIX,
+			target:   v1pb.RPN,
+			wantExpr: "x 1 + 2 ^ neg 1 2 max:2 /",
+		},
+		{
+			name:     "infixToInfix",
+			expr:     "((1 + 2)) + (3 * 4)",
+			notation: v1pb.INFIX,
+			target:   v1pb.INFIX,
+			wantExpr: "1 + 2 + 3 * 4",
+		},
+		{
+			name:      "syntaxError",
+			expr:      "(1 + 2",
+			notation:  v1pb.INFIX,
+			target:    v1pb.RPN,
+			wantError: &ParseError{Pos: 6, Msg: "missing closing parenthesis for the one at position 0"},
+		},
+		{
+			name:     "incompleteExpression",
+			expr:     "1 2 + 3",
+			notation: v1pb.RPN,
+			target:   v1pb.RPN,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			haveExpr, err := client.Convert(context.Background(), tc.expr, tc.notation, tc.target)
+			switch {
+			case tc.wantError != nil:
+				require.Equal(t, tc.wantError, err)
+			case tc.wantExpr == "":
+				eerr, ok := err.(*EvaluationError)
+				require.True(t, ok, "expected *EvaluationError, got %T", err)
+				require.Equal(t, v1pb.INCOMPLETE_EXPRESSION, eerr.Reason)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.wantExpr, haveExpr)
+			}
+		})
+	}
+}
+
+func TestEvaluateTree(t *testing.T) {
+	svc := NewService()
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	tokens, err := parseInfix("(x + 1) * sqrt(y)")
+	require.NoError(t, err)
+
+	expr, err := TokensToExpression(tokens)
+	require.NoError(t, err)
+
+	t.Run("result", func(t *testing.T) {
+		result, err := client.EvaluateTree(context.Background(), expr, map[string]float64{"x": 2, "y": 16})
+		require.NoError(t, err)
+		require.Equal(t, float64(12), result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := client.EvaluateTree(context.Background(), expr, map[string]float64{"x": 2, "y": -1})
+		eerr, ok := err.(*EvaluationError)
+		require.True(t, ok, "expected *EvaluationError, got %T", err)
+		require.Equal(t, v1pb.DOMAIN_ERROR, eerr.Reason)
+		require.Equal(t, 4, eerr.TokenIndex)
+	})
+
+	t.Run("explain", func(t *testing.T) {
+		resp, err := client.client.EvaluateBatch(context.Background(), &v1pb.EvaluateBatchRequest{
+			Expression: expr,
+			Bindings:   operandBindings(map[string]float64{"x": 2, "y": 16}),
+			Explain:    true,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Trace.Steps, 6)
+		require.Equal(t, &v1pb.TraceStep{Token: "*", Stack: []string{"12"}}, resp.Trace.Steps[5])
+	})
+
+	t.Run("tokensAndExpression", func(t *testing.T) {
+		_, err := client.client.EvaluateBatch(context.Background(), &v1pb.EvaluateBatchRequest{Tokens: tokens, Expression: expr})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSessions(t *testing.T) {
+	svc := NewService(WithMaxSessionsPerClient(2))
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	ctx := context.Background()
+	sess, err := client.CreateSession(ctx, v1pb.EXACT, 0)
+	require.NoError(t, err)
+	require.Empty(t, sess.Stack)
+
+	have, err := client.Push(ctx, sess.Id, "5", "sto:a", "3", "*")
+	require.NoError(t, err)
+	require.Equal(t, &v1pb.Session{Id: sess.Id, Stack: []string{"15"}, Registers: map[string]string{"a": "5"}}, have)
+
+	have, err = client.Push(ctx, sess.Id, "rcl:a", "0.1", "+")
+	require.NoError(t, err)
+	require.Equal(t, []string{"15", "5.1"}, have.Stack)
+
+	// a failing token leaves the session as it was before the call
+	_, err = client.Push(ctx, sess.Id, "drop", "sto:b", "rcl:c")
+	eerr, ok := err.(*EvaluationError)
+	require.True(t, ok, "expected *EvaluationError, got %T", err)
+	require.Equal(t, v1pb.EMPTY_REGISTER, eerr.Reason)
+	require.Equal(t, 2, eerr.TokenIndex)
+
+	have, err = client.GetSession(ctx, sess.Id)
+	require.NoError(t, err)
+	require.Equal(t, &v1pb.Session{Id: sess.Id, Stack: []string{"15", "5.1"}, Registers: map[string]string{"a": "5"}}, have)
+
+	// the client already holds one session so only one more is allowed
+	_, err = client.CreateSession(ctx, v1pb.FLOAT64, 0)
+	require.NoError(t, err)
+	_, err = client.CreateSession(ctx, v1pb.FLOAT64, 0)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	require.NoError(t, client.DeleteSession(ctx, sess.Id))
+	_, err = client.GetSession(ctx, sess.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.Push(ctx, sess.Id, "1")
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.CreateSession(ctx, v1pb.FLOAT64, 0)
+	require.NoError(t, err)
+}
+
+func TestHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "history")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	history, err := OpenHistoryStore(filepath.Join(dir, "history"), 0)
+	require.NoError(t, err)
+	defer history.Close()
+
+	svc := NewService(WithHistory(history))
+	addr, destroyFunc := startServer(t, svc)
+	defer destroyFunc()
+
+	client := createClient(t, addr)
+	defer client.Close()
+
+	ctx := context.Background()
+	start := time.Now()
+
+	_, err = client.EvaluateBatch(ctx, []string{"1", "2", "+"})
+	require.NoError(t, err)
+	_, err = client.EvaluateBatch(ctx, []string{"1", "+"})
+	require.Error(t, err)
+	_, err = client.EvaluateInfix(ctx, "2 * 3")
+	require.NoError(t, err)
+
+	entries, next, err := client.ListHistory(ctx, start, time.Time{}, "")
+	require.NoError(t, err)
+	require.Empty(t, next)
+	require.Len(t, entries, 3)
+
+	require.Equal(t, "EvaluateBatch", entries[0].Method)
+	require.Equal(t, "127.0.0.1", entries[0].Caller)
+	require.Equal(t, float64(3), entries[0].Result)
+	require.Equal(t, "3", entries[0].ResultDecimal)
+	require.Len(t, entries[0].Tokens, 3)
+
+	require.Equal(t, uint32(codes.InvalidArgument), entries[1].Code)
+	require.NotEmpty(t, entries[1].Error)
+	require.Equal(t, "6", entries[2].ResultDecimal)
+
+	t.Run("pagination", func(t *testing.T) {
+		var ids []uint64
+		var pageToken string
+		for {
+			resp, err := client.client.ListHistory(ctx, &v1pb.ListHistoryRequest{PageSize: 2, PageToken: pageToken})
+			require.NoError(t, err)
+			for _, e := range resp.Entries {
+				ids = append(ids, e.Id)
+			}
+
+			if pageToken = resp.NextPageToken; pageToken == "" {
+				break
+			}
+		}
+		require.Equal(t, []uint64{1, 2, 3}, ids)
+
+		_, err := client.client.ListHistory(ctx, &v1pb.ListHistoryRequest{PageToken: "!"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("timeRange", func(t *testing.T) {
+		entries, _, err := client.ListHistory(ctx, time.Time{}, start, "")
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("truncatedStream", func(t *testing.T) {
+		// 0 followed by n pairs of "1 +" is one token longer than the recorded limit
+		n := maxHistoryTokens / 2
+		tokens := make(chan string)
+		go func() {
+			tokens <- "0"
+			for i := 0; i < n; i++ {
+				tokens <- "1"
+				tokens <- "+"
+			}
+			close(tokens)
+		}()
+
+		result, err := client.EvaluateStream(tokens)
+		require.NoError(t, err)
+		require.Equal(t, float64(n), result)
+
+		entries, _, err := client.ListHistory(ctx, start, time.Time{}, "")
+		require.NoError(t, err)
+		last := entries[len(entries)-1]
+		require.Equal(t, "EvaluateStream", last.Method)
+		require.Len(t, last.Tokens, maxHistoryTokens)
+		require.True(t, last.Truncated)
+		require.Equal(t, float64(n), last.Result)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		addr, destroyFunc := startServer(t, NewService())
+		defer destroyFunc()
+
+		client := createClient(t, addr)
+		defer client.Close()
+
+		_, _, err := client.ListHistory(ctx, time.Time{}, time.Time{}, "")
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
 func startServer(t *testing.T, service *Service) (string, func()) {
 	t.Helper()
 
@@ -2,11 +2,13 @@ package calculator
 
 import (
 	"context"
+	"io"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/charithe/calculator/pkg/v1pb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
 // Client implements the RPC client for the Calculator service
@@ -22,73 +24,422 @@ func NewClient(conn *grpc.ClientConn) *Client {
 	}
 }
 
+// EvaluateStream is EvaluateStreamContext with a background context.
 func (c *Client) EvaluateStream(tokens <-chan string) (float64, error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	return c.EvaluateStreamContext(context.Background(), tokens)
+}
 
-	stream, err := c.client.EvaluateStream(ctx)
+// EvaluateStreamContext sends the tokens received from the channel to the server and returns the result once the
+// channel is closed. The call is aborted if ctx is cancelled or a token cannot be parsed.
+func (c *Client) EvaluateStreamContext(ctx context.Context, tokens <-chan string) (float64, error) {
+	stream, err := c.OpenStream(ctx)
 	if err != nil {
 		return 0, err
 	}
+	defer stream.Close()
 
-	for tokenStr := range tokens {
-		tok, err := parseToken(tokenStr)
-		if err != nil {
-			return 0, err
-		}
+	for {
+		select {
+		case <-ctx.Done():
+			return 0, status.FromContextError(ctx.Err()).Err()
+		case tokenStr, ok := <-tokens:
+			if !ok {
+				resp, err := stream.Result()
+				if err != nil {
+					return 0, err
+				}
+				return resp.Result, nil
+			}
 
-		if err := stream.Send(&v1pb.EvaluateStreamRequest{Token: tok}); err != nil {
-			return 0, err
+			if err := stream.Send(tokenStr); err != nil {
+				return 0, err
+			}
 		}
 	}
+}
 
-	resp, err := stream.CloseAndRecv()
+// OpenStream starts an EvaluateStream call that can be fed one token at a time.
+// The returned stream must be finished with either Result or Close to release the call.
+func (c *Client) OpenStream(ctx context.Context) (*TokenStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.EvaluateStream(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &TokenStream{stream: stream, cancel: cancel}, nil
+}
+
+// EvaluateInteractive opens a bidirectional stream that reports the state of the stack after each pushed token.
+func (c *Client) EvaluateInteractive(ctx context.Context) (*InteractiveStream, error) {
+	stream, err := c.client.EvaluateInteractive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InteractiveStream{stream: stream}, nil
+}
+
+// EvaluateBatch evaluates the tokens and returns the result.
+// Evaluation failures reported by the server are returned as *EvaluationError.
+func (c *Client) EvaluateBatch(ctx context.Context, tokenStrs []string) (float64, error) {
+	tokens, err := parseTokens(tokenStrs)
 	if err != nil {
 		return 0, err
 	}
 
+	resp, err := c.client.EvaluateBatch(ctx, &v1pb.EvaluateBatchRequest{Tokens: tokens})
+	if err != nil {
+		return 0, evaluationError(err, nil)
+	}
+
 	return resp.Result, nil
 }
 
-func (c *Client) EvaluateBatch(ctx context.Context, tokenStrs []string) (float64, error) {
-	tokens := make([]*v1pb.Token, len(tokenStrs))
-	for i, tokStr := range tokenStrs {
-		tok, err := parseToken(tokStr)
-		if err != nil {
-			return 0, err
-		}
+// EvaluateBatchWithBindings evaluates tokens that reference variables such as "x 2 * y +" with the given values.
+func (c *Client) EvaluateBatchWithBindings(ctx context.Context, tokenStrs []string, bindings map[string]float64) (float64, error) {
+	tokens, err := parseTokens(tokenStrs)
+	if err != nil {
+		return 0, err
+	}
 
-		tokens[i] = tok
+	req := &v1pb.EvaluateBatchRequest{Tokens: tokens, Bindings: operandBindings(bindings)}
+	resp, err := c.client.EvaluateBatch(ctx, req)
+	if err != nil {
+		return 0, evaluationError(err, nil)
 	}
 
-	resp, err := c.client.EvaluateBatch(ctx, &v1pb.EvaluateBatchRequest{Tokens: tokens})
+	return resp.Result, nil
+}
+
+// EvaluateTree evaluates an expression tree with the given variable bindings. Failures are reported as
+// *EvaluationError, with the token index referring to the equivalent tokens returned by ExpressionToTokens.
+func (c *Client) EvaluateTree(ctx context.Context, expr *v1pb.Expression, bindings map[string]float64) (float64, error) {
+	resp, err := c.client.EvaluateBatch(ctx, &v1pb.EvaluateBatchRequest{
+		Expression: expr,
+		Bindings:   operandBindings(bindings),
+	})
+	if err != nil {
+		return 0, evaluationError(err, nil)
+	}
+
+	return resp.Result, nil
+}
+
+// Explain evaluates the expression and returns the result along with a trace of the stack after each token.
+// If the evaluation fails, the error is an *EvaluationError with the trace up to the failing token.
+func (c *Client) Explain(ctx context.Context, expr string, notation v1pb.Notation, precision v1pb.Precision, floatPrecision uint32) (*v1pb.EvaluateBatchResponse, error) {
+	tokens, positions, err := parseExpressionPositions(expr, notation)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.EvaluateBatch(ctx, &v1pb.EvaluateBatchRequest{
+		Tokens:         tokens,
+		Precision:      precision,
+		FloatPrecision: floatPrecision,
+		Explain:        true,
+	})
+	if err != nil {
+		return nil, evaluationError(err, positions)
+	}
+
+	return resp, nil
+}
+
+// Compile checks the tokens and returns the ID of the program cached by the server for use with Execute.
+// Invalid programs are reported as *EvaluationError.
+func (c *Client) Compile(ctx context.Context, tokenStrs []string) (string, error) {
+	tokens, err := parseTokens(tokenStrs)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.client.Compile(ctx, &v1pb.CompileRequest{Tokens: tokens})
+	if err != nil {
+		return "", evaluationError(err, nil)
+	}
+
+	return resp.ProgramId, nil
+}
+
+// Execute runs a program returned by Compile with the given variable values.
+// A NotFound status means that the program has been evicted from the server cache and must be compiled again.
+func (c *Client) Execute(ctx context.Context, programID string, bindings map[string]float64) (float64, error) {
+	resp, err := c.client.Execute(ctx, &v1pb.ExecuteRequest{ProgramId: programID, Bindings: operandBindings(bindings)})
+	if err != nil {
+		return 0, evaluationError(err, nil)
+	}
+
+	return resp.Result, nil
+}
+
+// CreateSession starts a session whose stack and registers are kept by the server between calls. Sessions are removed
+// after a period of inactivity.
+func (c *Client) CreateSession(ctx context.Context, precision v1pb.Precision, floatPrecision uint32) (*v1pb.Session, error) {
+	resp, err := c.client.CreateSession(ctx, &v1pb.CreateSessionRequest{Precision: precision, FloatPrecision: floatPrecision})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Session, nil
+}
+
+// Push applies the tokens to the stack of the session and returns the new state. If any of the tokens fails, the
+// session is left unchanged and the error is an *EvaluationError.
+func (c *Client) Push(ctx context.Context, sessionID string, tokenStrs ...string) (*v1pb.Session, error) {
+	tokens, err := parseTokens(tokenStrs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Push(ctx, &v1pb.PushRequest{SessionId: sessionID, Tokens: tokens})
+	if err != nil {
+		return nil, evaluationError(err, nil)
+	}
+
+	return resp.Session, nil
+}
+
+func (c *Client) GetSession(ctx context.Context, sessionID string) (*v1pb.Session, error) {
+	resp, err := c.client.GetSession(ctx, &v1pb.GetSessionRequest{SessionId: sessionID})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Session, nil
+}
+
+func (c *Client) DeleteSession(ctx context.Context, sessionID string) error {
+	_, err := c.client.DeleteSession(ctx, &v1pb.DeleteSessionRequest{SessionId: sessionID})
+	return err
+}
+
+// ListHistory returns a page of the evaluations recorded by the server in the time range [start, end). Zero times remove
+// the corresponding limit. The returned token is passed to the next call to continue listing and is empty after the last
+// page.
+func (c *Client) ListHistory(ctx context.Context, start, end time.Time, pageToken string) ([]*v1pb.HistoryEntry, string, error) {
+	req := &v1pb.ListHistoryRequest{PageToken: pageToken}
+	if !start.IsZero() {
+		req.StartTimeUnixNano = start.UnixNano()
+	}
+	if !end.IsZero() {
+		req.EndTimeUnixNano = end.UnixNano()
+	}
+
+	resp, err := c.client.ListHistory(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resp.Entries, resp.NextPageToken, nil
+}
+
+// Validate checks an expression without evaluating it. The problems found are listed in the response.
+func (c *Client) Validate(ctx context.Context, expr string, notation v1pb.Notation) (*v1pb.ValidateResponse, error) {
+	return c.client.Validate(ctx, &v1pb.ValidateRequest{Expression: expr, Notation: notation})
+}
+
+// EvaluateVector evaluates the tokens once for every row of the columns, which hold the values of the variables.
+// Rows that cannot be evaluated have a NaN result and are described in the Errors field of the response.
+func (c *Client) EvaluateVector(ctx context.Context, tokenStrs []string, columns map[string][]float64) (*v1pb.EvaluateVectorResponse, error) {
+	tokens, err := parseTokens(tokenStrs)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &v1pb.EvaluateVectorRequest{Tokens: tokens, Columns: make(map[string]*v1pb.Column, len(columns))}
+	for name, values := range columns {
+		req.Columns[name] = &v1pb.Column{Values: values}
+	}
+
+	resp, err := c.client.EvaluateVector(ctx, req)
+	if err != nil {
+		return nil, evaluationError(err, nil)
+	}
+
+	return resp, nil
+}
+
+// EvaluateBatchDecimal evaluates the tokens using the given precision mode and returns the result as a decimal string.
+// floatPrecision is the mantissa size in bits for BIG_FLOAT and can be zero to use the server default.
+func (c *Client) EvaluateBatchDecimal(ctx context.Context, tokenStrs []string, precision v1pb.Precision, floatPrecision uint32) (string, error) {
+	tokens, err := parseTokens(tokenStrs)
+	if err != nil {
+		return "", err
+	}
+
+	return c.evaluateDecimal(ctx, tokens, nil, precision, floatPrecision)
+}
+
+// EvaluateInfix evaluates an infix expression such as "(5 + 8) * 3 - 2".
+// Syntax errors are reported as *ParseError and evaluation failures as *EvaluationError with Pos set.
+func (c *Client) EvaluateInfix(ctx context.Context, expr string) (float64, error) {
+	tokens, positions, err := parseInfixPositions(expr)
 	if err != nil {
 		return 0, err
 	}
 
+	resp, err := c.client.EvaluateBatch(ctx, &v1pb.EvaluateBatchRequest{Tokens: tokens})
+	if err != nil {
+		return 0, evaluationError(err, positions)
+	}
+
 	return resp.Result, nil
 }
 
+// EvaluateInfixDecimal is the arbitrary-precision variant of EvaluateInfix. See EvaluateBatchDecimal.
+func (c *Client) EvaluateInfixDecimal(ctx context.Context, expr string, precision v1pb.Precision, floatPrecision uint32) (string, error) {
+	tokens, positions, err := parseInfixPositions(expr)
+	if err != nil {
+		return "", err
+	}
+
+	return c.evaluateDecimal(ctx, tokens, positions, precision, floatPrecision)
+}
+
+func (c *Client) evaluateDecimal(ctx context.Context, tokens []*v1pb.Token, positions []int, precision v1pb.Precision, floatPrecision uint32) (string, error) {
+	resp, err := c.client.EvaluateBatch(ctx, &v1pb.EvaluateBatchRequest{
+		Tokens:         tokens,
+		Precision:      precision,
+		FloatPrecision: floatPrecision,
+	})
+	if err != nil {
+		return "", evaluationError(err, positions)
+	}
+
+	return resp.ResultDecimal, nil
+}
+
+// EvaluateExpression sends the raw expression to the server to be tokenized and evaluated.
+// Syntax errors reported by the server are returned as *ParseError and evaluation failures as *EvaluationError.
+func (c *Client) EvaluateExpression(ctx context.Context, expr string, notation v1pb.Notation) (float64, error) {
+	resp, err := c.client.EvaluateExpression(ctx, &v1pb.EvaluateExpressionRequest{Expression: expr, Notation: notation})
+	if err != nil {
+		return 0, expressionError(err, expr, notation)
+	}
+
+	return resp.Result, nil
+}
+
+// Convert rewrites the expression in the target notation. Infix expressions are written with the minimum number of
+// parentheses.
+func (c *Client) Convert(ctx context.Context, expr string, notation, target v1pb.Notation) (string, error) {
+	resp, err := c.client.Convert(ctx, &v1pb.ConvertRequest{Expression: expr, Notation: notation, TargetNotation: target})
+	if err != nil {
+		return "", expressionError(err, expr, notation)
+	}
+
+	return resp.Expression, nil
+}
+
 func (c *Client) Close() error {
 	return c.conn.Close()
 }
 
-func parseToken(tokenStr string) (*v1pb.Token, error) {
-	tokStr := strings.TrimSpace(tokenStr)
-	switch tokStr {
-	case "+":
-		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.ADD}}, nil
-	case "-":
-		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.SUBTRACT}}, nil
-	case "*":
-		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.MULTIPLY}}, nil
-	case "/":
-		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.DIVIDE}}, nil
-	default:
-		v, err := strconv.ParseFloat(tokStr, 64)
-		if err != nil {
-			return nil, err
+// expressionError converts the error of an RPC that tokenizes expr on the server to *ParseError or *EvaluationError.
+func expressionError(err error, expr string, notation v1pb.Notation) error {
+	for _, d := range status.Convert(err).Details() {
+		if perr, ok := d.(*v1pb.ParseError); ok {
+			return &ParseError{Pos: int(perr.Position), Msg: perr.Message}
 		}
-		return &v1pb.Token{Token: &v1pb.Token_Operand{Operand: &v1pb.Operand{Value: v}}}, nil
 	}
+
+	// the server tokenizes the expression the same way so the positions can be recovered locally
+	_, positions, _ := parseExpressionPositions(expr, notation)
+	return evaluationError(err, positions)
+}
+
+func operandBindings(bindings map[string]float64) map[string]*v1pb.Operand {
+	operands := make(map[string]*v1pb.Operand, len(bindings))
+	for name, v := range bindings {
+		// the shortest representation keeps values such as 0.1 exact in the EXACT precision mode
+		operands[name] = &v1pb.Operand{Value: v, Decimal: strconv.FormatFloat(v, 'g', -1, 64)}
+	}
+
+	return operands
+}
+
+// InteractiveStream is a handle to an EvaluateInteractive call.
+// This is not thread-safe and should only be accessed by a single goroutine.
+type InteractiveStream struct {
+	stream v1pb.Calculator_EvaluateInteractiveClient
+}
+
+// Push sends a single token and waits for the resulting stack state.
+// A token rejected by the server is reported in the Error field of the response and does not terminate the stream.
+func (s *InteractiveStream) Push(tokenStr string) (*v1pb.EvaluateInteractiveResponse, error) {
+	tok, err := parseToken(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.stream.Send(&v1pb.EvaluateInteractiveRequest{Token: tok}); err != nil {
+		return nil, err
+	}
+
+	return s.stream.Recv()
+}
+
+// Close terminates the stream.
+func (s *InteractiveStream) Close() error {
+	if err := s.stream.CloseSend(); err != nil {
+		return err
+	}
+
+	// drain the stream so that the call is completed
+	for {
+		if _, err := s.stream.Recv(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// TokenStream is a handle to an EvaluateStream call.
+// This is not thread-safe and should only be accessed by a single goroutine.
+type TokenStream struct {
+	stream v1pb.Calculator_EvaluateStreamClient
+	cancel context.CancelFunc
+}
+
+// Send parses the token and sends it to the server.
+// Tokens that cannot be parsed are not sent and the stream remains usable. If the server has rejected an earlier
+// token, the evaluation failure is returned as *EvaluationError.
+func (s *TokenStream) Send(tokenStr string) error {
+	tok, err := parseToken(tokenStr)
+	if err != nil {
+		return err
+	}
+
+	if err := s.stream.Send(&v1pb.EvaluateStreamRequest{Token: tok}); err != nil {
+		if err == io.EOF {
+			// the server has terminated the call and the reason is only available from the receiving side
+			_, err = s.Result()
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Result ends the stream and returns the result of the evaluation.
+func (s *TokenStream) Result() (*v1pb.EvaluateStreamResponse, error) {
+	defer s.cancel()
+
+	resp, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return nil, evaluationError(err, nil)
+	}
+
+	return resp, nil
+}
+
+// Close aborts the call without waiting for a result. It is safe to call Close after Result.
+func (s *TokenStream) Close() error {
+	s.cancel()
+	return nil
 }
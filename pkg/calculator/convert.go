@@ -0,0 +1,234 @@
+package calculator
+
+import (
+	"strings"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+)
+
+// atomPrecedence is the precedence of numbers, variables and function calls, which never need parentheses.
+const atomPrecedence = 5
+
+// maxInfixLength limits the size of converted expressions, which grow exponentially when dup or rcl repeat a
+// subexpression that already contains repetitions.
+const maxInfixLength = 4 << 20
+
+var errInfixTooLong = evalErrorf(v1pb.OUT_OF_RANGE, "infix expression exceeds %d bytes", maxInfixLength)
+
+// infixNode is an infix subexpression along with the precedence of its outermost operation. Its text is made of
+// strings and other nodes, so that repeated subexpressions are shared and the size is known before the text is built.
+type infixNode struct {
+	parts      []interface{}
+	size       int
+	precedence int
+}
+
+func newInfixNode(precedence int, parts ...interface{}) *infixNode {
+	n := &infixNode{parts: parts, precedence: precedence}
+	for _, p := range parts {
+		switch p := p.(type) {
+		case string:
+			n.size += len(p)
+		case *infixNode:
+			n.size += p.size
+		}
+	}
+
+	return n
+}
+
+// String builds the text of the node without recursion, as chains of operations can be nested arbitrarily deep.
+func (n *infixNode) String() string {
+	var sb strings.Builder
+	sb.Grow(n.size)
+
+	pending := []interface{}{n}
+	for len(pending) > 0 {
+		p := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		switch p := p.(type) {
+		case string:
+			sb.WriteString(p)
+		case *infixNode:
+			for i := len(p.parts) - 1; i >= 0; i-- {
+				pending = append(pending, p.parts[i])
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// parenthesized returns the parts of the node wrapped in parentheses if needed.
+func (n *infixNode) parenthesized(needed bool) []interface{} {
+	if needed {
+		return []interface{}{"(", n, ")"}
+	}
+
+	return []interface{}{n}
+}
+
+// FormatInfix converts RPN tokens to an infix expression with the minimum number of parentheses needed to preserve
+// the order of evaluation. Stack and register operations are expanded, so that dup repeats the subexpression at the
+// top of the stack. Token lists that do not reduce to a single value are reported as *EvaluationError.
+func FormatInfix(tokens []*v1pb.Token) (string, error) {
+	expr, err := infixString(tokens)
+	if err != nil {
+		return "", evaluationError(err, nil)
+	}
+
+	return expr, nil
+}
+
+// ParseInfix parses an infix expression such as "(5 + 8) * 3 - 2" into the equivalent RPN tokens.
+// Syntax errors are reported as *ParseError.
+func ParseInfix(expr string) ([]*v1pb.Token, error) {
+	return parseInfix(expr)
+}
+
+// formatRPN returns the tokens as a whitespace separated postfix expression.
+func formatRPN(tokens []*v1pb.Token) string {
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		words[i] = formatToken(t)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// infixString is FormatInfix returning a gRPC status error with the same details as evaluationStatus.
+func infixString(tokens []*v1pb.Token) (string, error) {
+	var stack []*infixNode
+	registers := make(map[string]*infixNode)
+	for i, t := range tokens {
+		needs, _, err := stackEffect(t)
+		if err == nil && len(stack) < needs {
+			err = errNotEnoughOperands
+		}
+
+		var node *infixNode
+		if err == nil {
+			switch v := t.GetToken().(type) {
+			case *v1pb.Token_Operand:
+				node, err = operandNode(v.Operand)
+			case *v1pb.Token_Variable:
+				node, err = variableNode(v.Variable.GetName())
+			case *v1pb.Token_Operator:
+				node = binaryNode(v.Operator, stack[len(stack)-2], stack[len(stack)-1])
+			case *v1pb.Token_Function:
+				name := strings.ToLower(v.Function.GetName())
+				_, arity, _ := lookupFunction(name, int(v.Function.GetArity()))
+				node = functionNode(name, stack[len(stack)-arity:])
+			case *v1pb.Token_StackOp:
+				stack = rearrange(stack, v.StackOp)
+				continue
+			case *v1pb.Token_Register:
+				if v.Register.GetOp() == v1pb.STO {
+					registers[v.Register.GetName()] = stack[len(stack)-1]
+					continue
+				}
+
+				var ok bool
+				if node, ok = registers[v.Register.GetName()]; !ok {
+					err = emptyRegisterError(v.Register.GetName())
+				}
+			}
+		}
+
+		if err == nil && node.size > maxInfixLength {
+			err = errInfixTooLong
+		}
+
+		if err != nil {
+			return "", evaluationStatus(err, i, t, len(stack), 0)
+		}
+
+		stack = append(stack[:len(stack)-needs], node)
+	}
+
+	if len(stack) != 1 {
+		return "", evaluationStatus(errIncomplete, -1, nil, len(stack), 0)
+	}
+
+	return stack[0].String(), nil
+}
+
+// operandNode returns the operand as written by the user. Signed operands bind like unary minus.
+func operandNode(operand *v1pb.Operand) (*infixNode, error) {
+	text := formatToken(&v1pb.Token{Token: &v1pb.Token_Operand{Operand: operand}})
+	unsigned := strings.TrimLeft(text, "+-")
+	if unsigned == "" || scanNumber(unsigned) != len(unsigned) {
+		return nil, evalErrorf(v1pb.INVALID_OPERAND, "operand %q cannot be written in infix notation", text)
+	}
+
+	if strings.HasPrefix(text, "-") {
+		return newInfixNode(unaryPrecedence, text), nil
+	}
+
+	return newInfixNode(atomPrecedence, unsigned), nil
+}
+
+// variableNode rejects names that the infix parser would read as something else, such as function names.
+func variableNode(name string) (*infixNode, error) {
+	if _, ok := functions[strings.ToLower(name)]; ok || !isIdent(name) {
+		return nil, evalErrorf(v1pb.INVALID_OPERAND, "variable %q cannot be written in infix notation", name)
+	}
+
+	return newInfixNode(atomPrecedence, name), nil
+}
+
+func binaryNode(op v1pb.Operator, left, right *infixNode) *infixNode {
+	sym := operatorSymbols[op]
+	bop := infixOperators[sym]
+
+	// operands of equal precedence need parentheses on the side that the operator does not associate with
+	parts := left.parenthesized(left.precedence < bop.precedence || (left.precedence == bop.precedence && bop.rightAssoc))
+	parts = append(parts, " "+sym+" ")
+
+	// unary minus is parsed before any binary operator so it never needs parentheses on the right
+	parts = append(parts, right.parenthesized(right.precedence != unaryPrecedence &&
+		(right.precedence < bop.precedence || (right.precedence == bop.precedence && !bop.rightAssoc)))...)
+
+	return newInfixNode(bop.precedence, parts...)
+}
+
+func functionNode(name string, args []*infixNode) *infixNode {
+	if name == "neg" {
+		parts := append([]interface{}{"-"}, args[0].parenthesized(args[0].precedence < unaryPrecedence)...)
+		return newInfixNode(unaryPrecedence, parts...)
+	}
+
+	if len(args) == 0 {
+		return newInfixNode(atomPrecedence, name)
+	}
+
+	parts := []interface{}{name + "("}
+	for i, a := range args {
+		if i > 0 {
+			parts = append(parts, ", ")
+		}
+		parts = append(parts, a)
+	}
+
+	return newInfixNode(atomPrecedence, append(parts, ")")...)
+}
+
+// rearrange applies a stack operation to the subexpressions. The number of operands has already been checked.
+func rearrange(stack []*infixNode, op v1pb.StackOp) []*infixNode {
+	n := len(stack)
+	switch op {
+	case v1pb.DUP:
+		return append(stack, stack[n-1])
+	case v1pb.SWAP:
+		stack[n-2], stack[n-1] = stack[n-1], stack[n-2]
+	case v1pb.DROP:
+		return stack[:n-1]
+	case v1pb.ROT:
+		stack[n-3], stack[n-2], stack[n-1] = stack[n-2], stack[n-1], stack[n-3]
+	case v1pb.CLEAR:
+		return stack[:0]
+	}
+
+	return stack
+}
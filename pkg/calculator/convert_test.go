@@ -0,0 +1,216 @@
+package calculator
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatInfix(t *testing.T) {
+	testCases := []struct {
+		rpn       string
+		wantInfix string
+	}{
+		{rpn: "1 2 + 3 *", wantInfix: "(1 + 2) * 3"},
+		{rpn: "1 2 3 * +", wantInfix: "1 + 2 * 3"},
+		{rpn: "1 2 - 3 -", wantInfix: "1 - 2 - 3"},
+		{rpn: "1 2 3 - -", wantInfix: "1 - (2 - 3)"},
+		{rpn: "1 2 3 + +", wantInfix: "1 + (2 + 3)"},
+		{rpn: "7 2 % 3 //", wantInfix: "7 % 2 // 3"},
+		{rpn: "2 3 ^ 4 ^", wantInfix: "(2 ^ 3) ^ 4"},
+		{rpn: "2 3 4 ^ ^", wantInfix: "2 ^ 3 ^ 4"},
+		{rpn: "-2 2 ^", wantInfix: "(-2) ^ 2"},
+		{rpn: "2 neg 2 ^", wantInfix: "(-2) ^ 2"},
+		{rpn: "2 2 ^ neg", wantInfix: "-2 ^ 2"},
+		{rpn: "1 2 + neg", wantInfix: "-(1 + 2)"},
+		{rpn: "2 3 neg *", wantInfix: "2 * -3"},
+		{rpn: "2 3 neg ^", wantInfix: "2 ^ -3"},
+		{rpn: "+4 1 -", wantInfix: "4 - 1"},
+		{rpn: "1.50 1e3 *", wantInfix: "1.50 * 1e3"},
+		{rpn: "x 2 * y_1 +", wantInfix: "x * 2 + y_1"},
+		{rpn: "1 2 3 max:3 sqrt pi *", wantInfix: "sqrt(max(1, 2, 3)) * pi"},
+		{rpn: "1 2 + 3 atan2", wantInfix: "atan2(1 + 2, 3)"},
+		{rpn: "3 dup *", wantInfix: "3 * 3"},
+		{rpn: "1 2 swap -", wantInfix: "2 - 1"},
+		{rpn: "1 2 3 rot - +", wantInfix: "2 + (3 - 1)"},
+		{rpn: "1 2 drop", wantInfix: "1"},
+		{rpn: "1 2 clear 3", wantInfix: "3"},
+		{rpn: "2 1 + sto:a 4 * rcl:a -", wantInfix: "(2 + 1) * 4 - (2 + 1)"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.rpn, func(t *testing.T) {
+			tokens, _, err := parseRPN(tc.rpn)
+			require.NoError(t, err)
+
+			haveInfix, err := FormatInfix(tokens)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantInfix, haveInfix)
+
+			// the infix expression must evaluate to the same result as the original tokens
+			infixTokens, err := ParseInfix(haveInfix)
+			require.NoError(t, err)
+			require.Equal(t, evaluateTokens(t, tokens), evaluateTokens(t, infixTokens))
+		})
+	}
+}
+
+func TestFormatInfixErrors(t *testing.T) {
+	nan := &v1pb.Token{Token: &v1pb.Token_Operand{Operand: &v1pb.Operand{Value: math.NaN()}}}
+	fnVar := &v1pb.Token{Token: &v1pb.Token_Variable{Variable: &v1pb.Variable{Name: "sqrt"}}}
+
+	testCases := []struct {
+		name      string
+		tokens    []*v1pb.Token
+		wantError *v1pb.EvaluationError
+	}{
+		{
+			name:      "notEnoughOperands",
+			tokens:    mustParseRPN(t, "1 2 + +"),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.NOT_ENOUGH_OPERANDS, TokenIndex: 3, Token: "+", StackDepth: 1},
+		},
+		{
+			name:      "incompleteExpression",
+			tokens:    mustParseRPN(t, "1 2"),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.INCOMPLETE_EXPRESSION, TokenIndex: -1, StackDepth: 2},
+		},
+		{
+			name:      "wrongArity",
+			tokens:    mustParseRPN(t, "1 sqrt:2"),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.WRONG_ARITY, TokenIndex: 1, Token: "sqrt:2", StackDepth: 1},
+		},
+		{
+			name:      "emptyRegister",
+			tokens:    mustParseRPN(t, "1 rcl:a +"),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.EMPTY_REGISTER, TokenIndex: 1, Token: "rcl:a", StackDepth: 1},
+		},
+		{
+			name:      "exponentialGrowth",
+			tokens:    mustParseRPN(t, "2"+strings.Repeat(" dup *", 30)),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.OUT_OF_RANGE, TokenIndex: 40, Token: "*", StackDepth: 2},
+		},
+		{
+			name:      "exponentialGrowthRegisters",
+			tokens:    mustParseRPN(t, "2 sto:a"+strings.Repeat(" rcl:a rcl:a + sto:a", 30)+" rcl:a"),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.OUT_OF_RANGE, TokenIndex: 80, Token: "+", StackDepth: 22},
+		},
+		{
+			name:      "nonFiniteOperand",
+			tokens:    []*v1pb.Token{nan},
+			wantError: &v1pb.EvaluationError{Reason: v1pb.INVALID_OPERAND, TokenIndex: 0, Token: "NaN"},
+		},
+		{
+			name:      "functionNameVariable",
+			tokens:    []*v1pb.Token{fnVar},
+			wantError: &v1pb.EvaluationError{Reason: v1pb.INVALID_OPERAND, TokenIndex: 0, Token: "sqrt"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := FormatInfix(tc.tokens)
+			eerr, ok := err.(*EvaluationError)
+			require.True(t, ok, "expected *EvaluationError, got %T", err)
+			require.Equal(t, tc.wantError, &v1pb.EvaluationError{
+				Reason:     eerr.Reason,
+				TokenIndex: int32(eerr.TokenIndex),
+				Token:      eerr.Token,
+				StackDepth: int32(eerr.StackDepth),
+			})
+		})
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	bindings := map[string]*v1pb.Operand{"x": {Value: 3}, "y": {Value: -0.5}}
+
+	for i := 0; i < 2000; i++ {
+		rpn := randomRPN(r, 5)
+		tokens, err := parseTokens(rpn)
+		require.NoError(t, err)
+
+		infix, err := FormatInfix(tokens)
+		require.NoError(t, err, "%v", rpn)
+
+		infixTokens, err := ParseInfix(infix)
+		require.NoError(t, err, "%v => %s", rpn, infix)
+
+		// converting the parsed expression again must produce the same text
+		again, err := FormatInfix(infixTokens)
+		require.NoError(t, err)
+		require.Equal(t, infix, again, "%v", rpn)
+
+		want, wantErr := evaluate(&rpnEvaluator{bindings: bindings}, tokens)
+		have, haveErr := evaluate(&rpnEvaluator{bindings: bindings}, infixTokens)
+		if wantErr != nil {
+			require.Error(t, haveErr, "%v => %s", rpn, infix)
+			continue
+		}
+
+		require.NoError(t, haveErr, "%v => %s", rpn, infix)
+		if math.IsNaN(want.(float64)) {
+			require.True(t, math.IsNaN(have.(float64)), "%v => %s", rpn, infix)
+			continue
+		}
+		require.Equal(t, want, have, "%v => %s", rpn, infix)
+	}
+}
+
+// randomRPN generates a random well-formed RPN expression with at most the given nesting depth.
+func randomRPN(r *rand.Rand, depth int) []string {
+	if depth == 0 || r.Intn(4) == 0 {
+		switch r.Intn(5) {
+		case 0:
+			return []string{"x"}
+		case 1:
+			return []string{"y"}
+		case 2:
+			return []string{"-" + strconv.Itoa(r.Intn(10))}
+		case 3:
+			return []string{strconv.FormatFloat(r.Float64()*10, 'f', 2, 64)}
+		default:
+			return []string{strconv.Itoa(r.Intn(10))}
+		}
+	}
+
+	binary := []string{"+", "-", "*", "/", "^", "%", "//"}
+	switch r.Intn(8) {
+	case 0:
+		return append(randomRPN(r, depth-1), []string{"neg", "abs", "sqrt"}[r.Intn(3)])
+	case 1:
+		n := 1 + r.Intn(3)
+		var tokens []string
+		for i := 0; i < n; i++ {
+			tokens = append(tokens, randomRPN(r, depth-1)...)
+		}
+		return append(tokens, "max:"+strconv.Itoa(n))
+	case 2:
+		return append(randomRPN(r, depth-1), "dup", binary[r.Intn(len(binary))])
+	case 3:
+		tokens := append(randomRPN(r, depth-1), randomRPN(r, depth-1)...)
+		return append(tokens, "swap", binary[r.Intn(len(binary))])
+	case 4:
+		tokens := append(randomRPN(r, depth-1), randomRPN(r, depth-1)...)
+		tokens = append(tokens, randomRPN(r, depth-1)...)
+		return append(tokens, "rot", binary[r.Intn(len(binary))], binary[r.Intn(len(binary))])
+	default:
+		tokens := append(randomRPN(r, depth-1), randomRPN(r, depth-1)...)
+		return append(tokens, binary[r.Intn(len(binary))])
+	}
+}
+
+func mustParseRPN(t *testing.T, expr string) []*v1pb.Token {
+	t.Helper()
+
+	tokens, _, err := parseRPN(expr)
+	require.NoError(t, err)
+	return tokens
+}
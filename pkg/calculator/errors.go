@@ -0,0 +1,105 @@
+package calculator
+
+import (
+	"fmt"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"google.golang.org/grpc/status"
+)
+
+// evalError is an evaluation failure classified by reason so that it can be reported to clients in a structured form.
+type evalError struct {
+	reason v1pb.ErrorReason
+	msg    string
+}
+
+func (e *evalError) Error() string {
+	return e.msg
+}
+
+func newEvalError(reason v1pb.ErrorReason, msg string) error {
+	return &evalError{reason: reason, msg: msg}
+}
+
+func evalErrorf(reason v1pb.ErrorReason, format string, args ...interface{}) error {
+	return &evalError{reason: reason, msg: fmt.Sprintf(format, args...)}
+}
+
+// errorReason returns the reason of an evaluator error.
+func errorReason(err error) v1pb.ErrorReason {
+	if e, ok := err.(*evalError); ok {
+		return e.reason
+	}
+
+	return v1pb.ERROR_REASON_UNSPECIFIED
+}
+
+// EvaluationError describes an expression that was rejected by the server during evaluation.
+type EvaluationError struct {
+	Reason v1pb.ErrorReason
+	// TokenIndex is the zero-based index of the failing token or -1 if the failure is not associated with a token
+	TokenIndex int
+	// Token is the text representation of the failing token
+	Token string
+	// StackDepth is the depth of the stack when the failure occurred
+	StackDepth int
+	// Pos is the zero-based byte offset of the failing token in the source expression or -1 if it is not known
+	Pos int
+	Msg string
+	// Trace holds the steps evaluated before the failure when explain was requested
+	Trace *v1pb.Trace
+	st    *status.Status
+}
+
+func (e *EvaluationError) Error() string {
+	if e.TokenIndex < 0 {
+		return e.Msg
+	}
+
+	return fmt.Sprintf("token %d (%q): %s", e.TokenIndex, e.Token, e.Msg)
+}
+
+// GRPCStatus returns the status received from the server so that status.Code and status.Convert work as expected.
+func (e *EvaluationError) GRPCStatus() *status.Status {
+	return e.st
+}
+
+// evaluationError converts a status error carrying a v1pb.EvaluationError detail to *EvaluationError.
+// positions holds the byte offset of each token in the source expression and may be nil.
+// Other errors are returned unchanged.
+func evaluationError(err error, positions []int) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var eerr *EvaluationError
+	var trace *v1pb.Trace
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *v1pb.EvaluationError:
+			eerr = &EvaluationError{
+				Reason:     detail.Reason,
+				TokenIndex: int(detail.TokenIndex),
+				Token:      detail.Token,
+				StackDepth: int(detail.StackDepth),
+				Pos:        -1,
+				Msg:        st.Message(),
+				st:         st,
+			}
+		case *v1pb.Trace:
+			trace = detail
+		}
+	}
+
+	if eerr == nil {
+		return err
+	}
+
+	if eerr.TokenIndex >= 0 && eerr.TokenIndex < len(positions) {
+		eerr.Pos = positions[eerr.TokenIndex]
+	}
+	eerr.Trace = trace
+
+	return eerr
+}
@@ -0,0 +1,82 @@
+package calculator
+
+import "math"
+
+// variadic is the arity of functions that accept any number of arguments greater than zero.
+const variadic = -1
+
+type function struct {
+	arity int
+	eval  func(args []float64) float64
+}
+
+func unary(f func(float64) float64) function {
+	return function{arity: 1, eval: func(args []float64) float64 { return f(args[0]) }}
+}
+
+func binaryFunc(f func(float64, float64) float64) function {
+	return function{arity: 2, eval: func(args []float64) float64 { return f(args[0], args[1]) }}
+}
+
+func constant(v float64) function {
+	return function{arity: 0, eval: func(_ []float64) float64 { return v }}
+}
+
+// functions is the library of named functions that can be applied to the stack.
+// Arguments are passed in the order they were pushed.
+var functions = map[string]function{
+	"pi": constant(math.Pi),
+	"e":  constant(math.E),
+
+	"neg":   unary(func(x float64) float64 { return -x }),
+	"abs":   unary(math.Abs),
+	"sqrt":  unary(math.Sqrt),
+	"cbrt":  unary(math.Cbrt),
+	"ln":    unary(math.Log),
+	"log2":  unary(math.Log2),
+	"log10": unary(math.Log10),
+	"exp":   unary(math.Exp),
+	"sin":   unary(math.Sin),
+	"cos":   unary(math.Cos),
+	"tan":   unary(math.Tan),
+	"asin":  unary(math.Asin),
+	"acos":  unary(math.Acos),
+	"atan":  unary(math.Atan),
+	"sinh":  unary(math.Sinh),
+	"cosh":  unary(math.Cosh),
+	"tanh":  unary(math.Tanh),
+	"floor": unary(math.Floor),
+	"ceil":  unary(math.Ceil),
+	"round": unary(math.Round),
+	"trunc": unary(math.Trunc),
+
+	"atan2": binaryFunc(math.Atan2),
+	"hypot": binaryFunc(math.Hypot),
+
+	"min": {arity: variadic, eval: func(args []float64) float64 {
+		m := args[0]
+		for _, v := range args[1:] {
+			m = math.Min(m, v)
+		}
+		return m
+	}},
+	"max": {arity: variadic, eval: func(args []float64) float64 {
+		m := args[0]
+		for _, v := range args[1:] {
+			m = math.Max(m, v)
+		}
+		return m
+	}},
+	"sum": {arity: variadic, eval: sum},
+	"avg": {arity: variadic, eval: func(args []float64) float64 {
+		return sum(args) / float64(len(args))
+	}},
+}
+
+func sum(args []float64) float64 {
+	var s float64
+	for _, v := range args {
+		s += v
+	}
+	return s
+}
@@ -0,0 +1,240 @@
+package calculator
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// OpenAPIPath is the path of the OpenAPI document describing the JSON endpoints of the HTTP handler.
+const OpenAPIPath = "/v1/openapi.json"
+
+// httpRoute maps a JSON endpoint onto a unary RPC of the service.
+type httpRoute struct {
+	path        string
+	rpc         string
+	summary     string
+	newRequest  func() proto.Message
+	newResponse func() proto.Message
+	call        func(ctx context.Context, svc *Service, req proto.Message) (proto.Message, error)
+}
+
+var httpRoutes = []httpRoute{
+	{
+		path:        "/v1/evaluate:batch",
+		rpc:         "EvaluateBatch",
+		summary:     "Evaluate a list of RPN tokens or an expression tree",
+		newRequest:  func() proto.Message { return &v1pb.EvaluateBatchRequest{} },
+		newResponse: func() proto.Message { return &v1pb.EvaluateBatchResponse{} },
+		call: func(ctx context.Context, svc *Service, req proto.Message) (proto.Message, error) {
+			return svc.EvaluateBatch(ctx, req.(*v1pb.EvaluateBatchRequest))
+		},
+	},
+	{
+		path:        "/v1/evaluate:expression",
+		rpc:         "EvaluateExpression",
+		summary:     "Evaluate an expression written in RPN or infix notation",
+		newRequest:  func() proto.Message { return &v1pb.EvaluateExpressionRequest{} },
+		newResponse: func() proto.Message { return &v1pb.EvaluateExpressionResponse{} },
+		call: func(ctx context.Context, svc *Service, req proto.Message) (proto.Message, error) {
+			return svc.EvaluateExpression(ctx, req.(*v1pb.EvaluateExpressionRequest))
+		},
+	},
+}
+
+// jsonMarshaler renders zero values so that, for example, a zero result is not omitted from the response.
+var jsonMarshaler = &jsonpb.Marshaler{EmitDefaults: true}
+
+// NewHTTPHandler exposes the evaluation RPCs of the service as JSON endpoints accepting POST requests, along with the
+// OpenAPI document describing them at OpenAPIPath. Request and response bodies use the protobuf JSON mapping.
+// Failures are reported with the HTTP status corresponding to the gRPC code and a body in the JSON form of
+// google.rpc.Status. Request bodies larger than maxBodySize are rejected.
+func NewHTTPHandler(svc *Service, maxBodySize int64) http.Handler {
+	mux := http.NewServeMux()
+	for _, route := range httpRoutes {
+		route := route
+		mux.HandleFunc(route.path, func(w http.ResponseWriter, r *http.Request) {
+			serveRoute(w, r, svc, route, maxBodySize)
+		})
+	}
+
+	mux.HandleFunc(OpenAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeHTTPError(w, status.Error(codes.Unimplemented, "method not allowed"), http.StatusMethodNotAllowed)
+			return
+		}
+
+		spec, err := OpenAPISpec()
+		if err != nil {
+			writeHTTPError(w, status.Errorf(codes.Internal, "failed to generate OpenAPI document: %v", err), 0)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(spec)
+	})
+
+	return mux
+}
+
+func serveRoute(w http.ResponseWriter, r *http.Request, svc *Service, route httpRoute, maxBodySize int64) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeHTTPError(w, status.Error(codes.Unimplemented, "method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+
+	req := route.newRequest()
+	if err := decodeJSONRequest(w, r, req, maxBodySize); err != nil {
+		writeHTTPError(w, err, 0)
+		return
+	}
+
+	resp, err := route.call(httpContext(r), svc, req)
+	if err != nil {
+		writeHTTPError(w, err, 0)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := jsonMarshaler.Marshal(w, resp); err != nil {
+		zap.S().Warnw("Failed to write HTTP response", "path", route.path, "error", err)
+	}
+}
+
+// decodeJSONRequest reads the body of the request into msg and returns an InvalidArgument status if it is malformed.
+func decodeJSONRequest(w http.ResponseWriter, r *http.Request, msg proto.Message, maxBodySize int64) error {
+	body := r.Body
+	if maxBodySize > 0 {
+		body = http.MaxBytesReader(w, body, maxBodySize)
+	}
+	defer body.Close()
+
+	if err := jsonpb.Unmarshal(body, msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+
+	return nil
+}
+
+// httpContext returns the context of the request with the address of the caller recorded as the gRPC peer, so that
+// the service identifies HTTP clients in the same way as gRPC clients.
+func httpContext(r *http.Request) context.Context {
+	addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr)
+	if err != nil {
+		return r.Context()
+	}
+
+	return peer.NewContext(r.Context(), &peer.Peer{Addr: addr})
+}
+
+// httpStatus returns the HTTP status code corresponding to a gRPC code.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		// non-standard code used by nginx and grpc-gateway for requests cancelled by the client
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// httpErrorBody is the JSON form of google.rpc.Status. Each detail is the JSON form of the message with an "@type"
+// field holding its type URL, as in the JSON mapping of google.protobuf.Any.
+type httpErrorBody struct {
+	Code    codes.Code        `json:"code"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+// writeHTTPError writes the status of the error. The HTTP status code is derived from the gRPC code unless httpCode is
+// non-zero.
+func writeHTTPError(w http.ResponseWriter, err error, httpCode int) {
+	st := status.Convert(err)
+	if httpCode == 0 {
+		httpCode = httpStatus(st.Code())
+	}
+
+	body := httpErrorBody{Code: st.Code(), Message: st.Message(), Details: statusDetailsJSON(st)}
+	buf, err := json.Marshal(body)
+	if err != nil {
+		zap.S().Warnw("Failed to encode HTTP error", "error", err)
+		http.Error(w, st.Message(), httpCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(httpCode)
+	w.Write(buf)
+}
+
+// statusDetailsJSON converts the details of the status to JSON. Details of unknown types are left out.
+func statusDetailsJSON(st *status.Status) []json.RawMessage {
+	anys := st.Proto().GetDetails()
+	var details []json.RawMessage
+	for i, d := range st.Details() {
+		msg, ok := d.(proto.Message)
+		if !ok {
+			continue
+		}
+
+		detail, err := anyJSON(anys[i].GetTypeUrl(), msg)
+		if err != nil {
+			zap.S().Warnw("Failed to encode error detail", "type", anys[i].GetTypeUrl(), "error", err)
+			continue
+		}
+		details = append(details, detail)
+	}
+
+	return details
+}
+
+func anyJSON(typeURL string, msg proto.Message) (json.RawMessage, error) {
+	s, err := (&jsonpb.Marshaler{}).MarshalToString(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &fields); err != nil {
+		return nil, err
+	}
+
+	fields["@type"], err = json.Marshal(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,142 @@
+package calculator
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var updateOpenAPI = flag.Bool("update_openapi", false, "update the OpenAPI document in pkg/v1pb")
+
+const openAPIFile = "../v1pb/calculator.openapi.json"
+
+func TestHTTPHandler(t *testing.T) {
+	srv := httptest.NewServer(NewHTTPHandler(NewService(WithMaxStackDepth(2)), 1024))
+	defer srv.Close()
+
+	testCases := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "batch",
+			method:     http.MethodPost,
+			path:       "/v1/evaluate:batch",
+			body:       `{"tokens": [{"operand": {"value": 5}}, {"operand": {"value": 8}}, {"operator": "ADD"}], "precision": "EXACT"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"result": 13, "resultDecimal": "13", "resultFraction": "13", "trace": null}`,
+		},
+		{
+			name:       "zeroResult",
+			method:     http.MethodPost,
+			path:       "/v1/evaluate:batch",
+			body:       `{"tokens": [{"operand": {"value": 0}}]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"result": 0, "resultDecimal": "0", "resultFraction": "", "trace": null}`,
+		},
+		{
+			name:       "expression",
+			method:     http.MethodPost,
+			path:       "/v1/evaluate:expression",
+			body:       `{"expression": "(x + 1) * 2", "notation": "INFIX", "bindings": {"x": {"value": 3}}}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"result": 8}`,
+		},
+		{
+			name:       "evaluationError",
+			method:     http.MethodPost,
+			path:       "/v1/evaluate:expression",
+			body:       `{"expression": "1 +"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{"code": 3, "message": "not enough operands", "details": [
+				{"@type": "type.googleapis.com/com.github.charithe.calculator.v1.EvaluationError", "reason": "NOT_ENOUGH_OPERANDS", "tokenIndex": 1, "token": "+", "stackDepth": 1},
+				{"@type": "type.googleapis.com/google.rpc.BadRequest", "fieldViolations": [{"field": "tokens[1]", "description": "not enough operands"}]}
+			]}`,
+		},
+		{
+			name:       "stackFull",
+			method:     http.MethodPost,
+			path:       "/v1/evaluate:expression",
+			body:       `{"expression": "1 2 3 + +"}`,
+			wantStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:       "malformedBody",
+			method:     http.MethodPost,
+			path:       "/v1/evaluate:batch",
+			body:       `{"tokens": 1}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknownField",
+			method:     http.MethodPost,
+			path:       "/v1/evaluate:batch",
+			body:       `{"token": []}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "bodyTooLarge",
+			method:     http.MethodPost,
+			path:       "/v1/evaluate:expression",
+			body:       `{"expression": "` + strings.Repeat("1 ", 1024) + `"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "wrongMethod",
+			method:     http.MethodGet,
+			path:       "/v1/evaluate:batch",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "openAPI",
+			method:     http.MethodGet,
+			path:       OpenAPIPath,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, srv.URL+tc.path, strings.NewReader(tc.body))
+			require.NoError(t, err)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStatus, resp.StatusCode, "%s", body)
+			require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+			require.True(t, json.Valid(body), "%s", body)
+
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, string(body))
+			}
+		})
+	}
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	have, err := OpenAPISpec()
+	require.NoError(t, err)
+
+	if *updateOpenAPI {
+		require.NoError(t, ioutil.WriteFile(openAPIFile, have, 0644))
+	}
+
+	want, err := ioutil.ReadFile(openAPIFile)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(have), "run go test -run TestOpenAPISpec -update_openapi to update %s", openAPIFile)
+}
@@ -0,0 +1,369 @@
+package calculator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"go.uber.org/zap"
+)
+
+// maxHistoryEntrySize guards against allocating huge buffers when reading a corrupt history file.
+const maxHistoryEntrySize = 1 << 28
+
+// maxHistoryTokens is the maximum number of tokens of a streamed evaluation recorded in its history entry.
+const maxHistoryTokens = 10000
+
+// pruneInterval is the minimum time between two removals of the entries that are older than the retention period.
+const pruneInterval = time.Minute
+
+var errHistoryClosed = errors.New("history store is closed")
+
+// errTruncatedHistoryEntry reports an entry that ends past the end of the file, as left behind when the process stops
+// while writing it.
+var errTruncatedHistoryEntry = errors.New("truncated entry")
+
+// HistoryStore records evaluations in an append-only file so that they can be listed later.
+// Each entry is stored as a v1pb.HistoryEntry preceded by its length as a varint. Only the ID, time and location of
+// each entry are kept in memory and the entries are read from the file when listed. When expired entries are removed,
+// the file is rewritten starting with an entry that only holds the last assigned ID, so that IDs are never reused.
+// This is safe for concurrent use.
+type HistoryStore struct {
+	path      string
+	retention time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	file      *os.File
+	size      int64
+	index     []historyIndexEntry
+	nextID    uint64
+	lastPrune time.Time
+}
+
+// historyIndexEntry locates an entry in the history file.
+type historyIndexEntry struct {
+	id           uint64
+	timeUnixNano int64
+	offset       int64
+	length       int
+}
+
+// OpenHistoryStore opens the history file at path, creating it if it does not exist. Entries older than the retention
+// period are removed from the file. Zero retention keeps all entries. A file that is corrupt anywhere but in its last
+// entry is reported as an error rather than repaired, so that no recorded evaluation is silently lost.
+func OpenHistoryStore(path string, retention time.Duration) (*HistoryStore, error) {
+	h := &HistoryStore{path: path, retention: retention, now: time.Now, nextID: 1}
+
+	if err := h.load(); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	h.file = f
+
+	if err := h.prune(h.now()); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// load indexes the entries of the history file. A truncated entry at the end of the file, which is left behind if the
+// process stops while writing, is discarded.
+func (h *HistoryStore) load() error {
+	f, err := os.OpenFile(h.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	var offset int64
+	for {
+		entry, n, err := readHistoryEntry(r)
+		switch {
+		case err == io.EOF:
+			h.size = offset
+			return nil
+		case err == errTruncatedHistoryEntry:
+			zap.S().Warnw("Discarding truncated history entry", "path", h.path, "offset", offset)
+			h.size = offset
+			return f.Truncate(offset)
+		case err != nil:
+			return fmt.Errorf("history file %s is corrupt at offset %d: %v", h.path, offset, err)
+		}
+
+		if entry.Id >= h.nextID {
+			h.nextID = entry.Id + 1
+		}
+
+		// entries without a time only carry the last ID assigned before the file was rewritten
+		if entry.TimeUnixNano != 0 {
+			h.index = append(h.index, historyIndexEntry{id: entry.Id, timeUnixNano: entry.TimeUnixNano, offset: offset, length: n})
+		}
+		offset += int64(n)
+	}
+}
+
+func readHistoryEntry(r *bufio.Reader) (*v1pb.HistoryEntry, int, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		switch err {
+		case io.EOF:
+			return nil, 0, io.EOF
+		case io.ErrUnexpectedEOF:
+			return nil, 0, errTruncatedHistoryEntry
+		default:
+			return nil, 0, fmt.Errorf("failed to read entry size: %v", err)
+		}
+	}
+
+	if size > maxHistoryEntrySize {
+		return nil, 0, fmt.Errorf("entry size %d exceeds the limit", size)
+	}
+
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, 0, errTruncatedHistoryEntry
+		}
+		return nil, 0, fmt.Errorf("failed to read entry: %v", err)
+	}
+
+	entry := &v1pb.HistoryEntry{}
+	if err := entry.Unmarshal(buf); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode entry: %v", err)
+	}
+
+	var sizeBuf [binary.MaxVarintLen64]byte
+	return entry, binary.PutUvarint(sizeBuf[:], size) + len(buf), nil
+}
+
+func encodeHistoryEntry(entry *v1pb.HistoryEntry) ([]byte, error) {
+	buf, err := entry.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var sizeBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(sizeBuf[:], uint64(len(buf)))
+	return append(sizeBuf[:n], buf...), nil
+}
+
+// Record assigns an ID and a timestamp to the entry and appends it to the history.
+func (h *HistoryStore) Record(entry *v1pb.HistoryEntry) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.file == nil {
+		return errHistoryClosed
+	}
+
+	now := h.now()
+	// a negative interval means that the clock has been set back since the last removal
+	if sinceLastPrune := now.Sub(h.lastPrune); sinceLastPrune >= pruneInterval || sinceLastPrune < 0 {
+		if err := h.prune(now); err != nil {
+			zap.S().Warnw("Failed to remove expired history entries", "error", err)
+		}
+	}
+
+	entry.Id = h.nextID
+	entry.TimeUnixNano = now.UnixNano()
+
+	buf, err := encodeHistoryEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	if _, err := h.file.Write(buf); err != nil {
+		// remove any partial write so that the following entries are not appended after it
+		if truncErr := h.file.Truncate(h.size); truncErr != nil {
+			zap.S().Warnw("Failed to remove partial history entry", "error", truncErr)
+		}
+		return err
+	}
+
+	h.index = append(h.index, historyIndexEntry{id: entry.Id, timeUnixNano: entry.TimeUnixNano, offset: h.size, length: len(buf)})
+	h.size += int64(len(buf))
+	h.nextID++
+	return nil
+}
+
+// List returns up to limit entries with an ID greater than afterID that were recorded in the time range
+// [start, end). Zero start and end times remove the corresponding limit. The second return value reports whether
+// there are more matching entries.
+func (h *HistoryStore) List(start, end time.Time, afterID uint64, limit int) ([]*v1pb.HistoryEntry, bool, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.file == nil {
+		return nil, false, errHistoryClosed
+	}
+
+	i := sort.Search(len(h.index), func(i int) bool { return h.index[i].id > afterID })
+
+	var entries []*v1pb.HistoryEntry
+	for ; i < len(h.index); i++ {
+		e := h.index[i]
+		if (!start.IsZero() && e.timeUnixNano < start.UnixNano()) || (!end.IsZero() && e.timeUnixNano >= end.UnixNano()) {
+			continue
+		}
+
+		if len(entries) == limit {
+			return entries, true, nil
+		}
+
+		entry, err := h.readEntry(e)
+		if err != nil {
+			return nil, false, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, false, nil
+}
+
+// readEntry reads an indexed entry from the file. The caller must hold the lock.
+func (h *HistoryStore) readEntry(e historyIndexEntry) (*v1pb.HistoryEntry, error) {
+	buf := make([]byte, e.length)
+	if _, err := h.file.ReadAt(buf, e.offset); err != nil {
+		return nil, fmt.Errorf("failed to read history entry %d: %v", e.id, err)
+	}
+
+	entry, _, err := readHistoryEntry(bufio.NewReader(bytes.NewReader(buf)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history entry %d: %v", e.id, err)
+	}
+
+	return entry, nil
+}
+
+// prune removes the entries that are older than the retention period and rewrites the file without them.
+// The entries are in the order they were recorded, which is not necessarily the order of their timestamps if the clock
+// has been set back in the meantime, so every entry is checked. The caller must hold the lock.
+func (h *HistoryStore) prune(now time.Time) error {
+	h.lastPrune = now
+	if h.retention <= 0 {
+		return nil
+	}
+
+	cutoff := now.Add(-h.retention).UnixNano()
+	expired := 0
+	for _, e := range h.index {
+		if e.timeUnixNano < cutoff {
+			expired++
+		}
+	}
+
+	if expired == 0 {
+		return nil
+	}
+
+	// the kept entries are copied as they are, after an entry recording the last assigned ID
+	header, err := encodeHistoryEntry(&v1pb.HistoryEntry{Id: h.nextID - 1})
+	if err != nil {
+		return err
+	}
+
+	index := make([]historyIndexEntry, 0, len(h.index)-expired)
+	var sections []io.Reader
+	var runOffset, runLength int64
+	size := int64(len(header))
+	for _, e := range h.index {
+		if e.timeUnixNano < cutoff {
+			continue
+		}
+
+		// adjacent entries are copied together
+		if runLength > 0 && runOffset+runLength != e.offset {
+			sections = append(sections, io.NewSectionReader(h.file, runOffset, runLength))
+			runLength = 0
+		}
+
+		if runLength == 0 {
+			runOffset = e.offset
+		}
+		runLength += int64(e.length)
+
+		e.offset = size
+		index = append(index, e)
+		size += int64(e.length)
+	}
+
+	if runLength > 0 {
+		sections = append(sections, io.NewSectionReader(h.file, runOffset, runLength))
+	}
+
+	tmpPath := h.path + ".tmp"
+	if err := writeHistoryFile(tmpPath, header, io.MultiReader(sections...)); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	if err := os.Rename(tmpPath, h.path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	f, err := os.OpenFile(h.path, os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	h.file.Close()
+	h.file = f
+	h.index = index
+	h.size = size
+	return nil
+}
+
+func writeHistoryFile(path string, header []byte, entries io.Reader) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(header); err != nil {
+		f.Close()
+		return err
+	}
+
+	if _, err := io.Copy(f, entries); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Close closes the history file.
+func (h *HistoryStore) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.file == nil {
+		return nil
+	}
+
+	err := h.file.Close()
+	h.file = nil
+	return err
+}
@@ -0,0 +1,198 @@
+package calculator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "history")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "history")
+	start := time.Now()
+	now := start
+	clock := func() time.Time { return now }
+
+	history, err := OpenHistoryStore(path, time.Hour)
+	require.NoError(t, err)
+	history.now = clock
+
+	for _, method := range []string{"a", "b", "c"} {
+		require.NoError(t, history.Record(&v1pb.HistoryEntry{Method: method}))
+		now = now.Add(time.Minute)
+	}
+
+	t.Run("list", func(t *testing.T) {
+		entries, more, err := history.List(time.Time{}, time.Time{}, 0, 2)
+		require.NoError(t, err)
+		require.True(t, more)
+		require.Equal(t, []string{"a", "b"}, historyMethods(entries))
+		require.Equal(t, uint64(1), entries[0].Id)
+		require.Equal(t, start.UnixNano(), entries[0].TimeUnixNano)
+
+		entries, more, err = history.List(time.Time{}, time.Time{}, entries[1].Id, 2)
+		require.NoError(t, err)
+		require.False(t, more)
+		require.Equal(t, []string{"c"}, historyMethods(entries))
+	})
+
+	t.Run("timeRange", func(t *testing.T) {
+		entries, more, err := history.List(start.Add(time.Minute), start.Add(2*time.Minute), 0, 10)
+		require.NoError(t, err)
+		require.False(t, more)
+		require.Equal(t, []string{"b"}, historyMethods(entries))
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, history.Close())
+		require.Equal(t, errHistoryClosed, history.Record(&v1pb.HistoryEntry{}))
+
+		// a partially written entry at the end of the file is discarded
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+		require.NoError(t, err)
+		_, err = f.Write([]byte{0x10, 0x01})
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		history, err = OpenHistoryStore(path, time.Hour)
+		require.NoError(t, err)
+		history.now = clock
+
+		require.NoError(t, history.Record(&v1pb.HistoryEntry{Method: "d"}))
+		entries, _, err := history.List(time.Time{}, time.Time{}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b", "c", "d"}, historyMethods(entries))
+		require.Equal(t, uint64(4), entries[3].Id)
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		// the first two entries are more than an hour old by now
+		now = start.Add(time.Hour + 90*time.Second)
+		require.NoError(t, history.Record(&v1pb.HistoryEntry{Method: "e"}))
+
+		entries, _, err := history.List(time.Time{}, time.Time{}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"c", "d", "e"}, historyMethods(entries))
+
+		require.NoError(t, history.Close())
+		history, err = OpenHistoryStore(path, 0)
+		require.NoError(t, err)
+
+		entries, _, err = history.List(time.Time{}, time.Time{}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"c", "d", "e"}, historyMethods(entries))
+		require.Equal(t, uint64(5), entries[2].Id)
+		require.NoError(t, history.Close())
+	})
+
+	t.Run("idsAfterRemovingAll", func(t *testing.T) {
+		now = start.Add(3 * time.Hour)
+		history, err = OpenHistoryStore(path, time.Hour)
+		require.NoError(t, err)
+		history.now = clock
+		require.NoError(t, history.prune(now))
+
+		// all entries have expired but their IDs are not reused
+		entries, _, err := history.List(time.Time{}, time.Time{}, 0, 10)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+		require.NoError(t, history.Close())
+
+		history, err = OpenHistoryStore(path, time.Hour)
+		require.NoError(t, err)
+		history.now = clock
+		defer history.Close()
+
+		require.NoError(t, history.Record(&v1pb.HistoryEntry{Method: "f"}))
+		entries, _, err = history.List(time.Time{}, time.Time{}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"f"}, historyMethods(entries))
+		require.Equal(t, uint64(6), entries[0].Id)
+	})
+}
+
+func TestHistoryStoreClockSetBack(t *testing.T) {
+	dir, err := ioutil.TempDir("", "history")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "history")
+	start := time.Now()
+	now := start
+
+	history, err := OpenHistoryStore(path, time.Hour)
+	require.NoError(t, err)
+	history.now = func() time.Time { return now }
+
+	// b is recorded after a but has an older timestamp
+	for _, at := range []struct {
+		method string
+		time   time.Time
+	}{{"a", start}, {"b", start.Add(-2 * time.Hour)}, {"c", start.Add(time.Minute)}} {
+		now = at.time
+		require.NoError(t, history.Record(&v1pb.HistoryEntry{Method: at.method}))
+	}
+
+	require.NoError(t, history.prune(start.Add(30*time.Minute)))
+	entries, _, err := history.List(time.Time{}, time.Time{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c"}, historyMethods(entries))
+	require.NoError(t, history.Close())
+
+	history, err = OpenHistoryStore(path, 0)
+	require.NoError(t, err)
+	defer history.Close()
+
+	entries, _, err = history.List(time.Time{}, time.Time{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c"}, historyMethods(entries))
+	require.Equal(t, []uint64{1, 3}, []uint64{entries[0].Id, entries[1].Id})
+}
+
+func TestHistoryStoreCorruption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "history")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "history")
+	history, err := OpenHistoryStore(path, 0)
+	require.NoError(t, err)
+
+	for _, method := range []string{"a", "b", "c"} {
+		require.NoError(t, history.Record(&v1pb.HistoryEntry{Method: method}))
+	}
+	require.NoError(t, history.Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	// an invalid wire type in the second entry cannot be decoded
+	corrupt := append([]byte(nil), data...)
+	corrupt[history.index[1].offset+1] = 0xff
+	require.NoError(t, ioutil.WriteFile(path, corrupt, 0600))
+
+	_, err = OpenHistoryStore(path, 0)
+	require.Error(t, err)
+
+	// the file is left as it was for the operator to inspect
+	have, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, corrupt, have)
+}
+
+func historyMethods(entries []*v1pb.HistoryEntry) []string {
+	methods := make([]string, len(entries))
+	for i, e := range entries {
+		methods[i] = e.Method
+	}
+
+	return methods
+}
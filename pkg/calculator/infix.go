@@ -0,0 +1,349 @@
+package calculator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+)
+
+// ParseError describes a syntax error in an expression.
+type ParseError struct {
+	// Pos is the zero-based byte offset of the offending input
+	Pos int
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("syntax error at position %d: %s", e.Pos, e.Msg)
+}
+
+type lexemeKind int
+
+const (
+	lexNumber lexemeKind = iota
+	lexOperator
+	lexLeftParen
+	lexRightParen
+	lexComma
+	lexIdent
+	lexEOF
+)
+
+type lexeme struct {
+	kind lexemeKind
+	text string
+	pos  int
+}
+
+type binaryOperator struct {
+	op         v1pb.Operator
+	precedence int
+	rightAssoc bool
+}
+
+// infixOperators maps the infix symbols to their RPN operator and binding rules.
+var infixOperators = map[string]binaryOperator{
+	"+":  {op: v1pb.ADD, precedence: 1},
+	"-":  {op: v1pb.SUBTRACT, precedence: 1},
+	"*":  {op: v1pb.MULTIPLY, precedence: 2},
+	"/":  {op: v1pb.DIVIDE, precedence: 2},
+	"%":  {op: v1pb.MOD, precedence: 2},
+	"//": {op: v1pb.IDIV, precedence: 2},
+	"^":  {op: v1pb.POW, precedence: 4, rightAssoc: true},
+}
+
+// unaryPrecedence binds unary minus tighter than multiplication but looser than exponentiation so that -2^2 == -4
+const unaryPrecedence = 3
+
+// maxInfixDepth limits the nesting of parentheses, unary operators and right-associative operators so that hostile
+// expressions cannot exhaust the stack of the recursive parser.
+const maxInfixDepth = 256
+
+func lex(expr string) ([]lexeme, error) {
+	var lexemes []lexeme
+
+	for i := 0; i < len(expr); {
+		c, width := utf8.DecodeRuneInString(expr[i:])
+		switch {
+		case c == utf8.RuneError && width == 1:
+			return nil, &ParseError{Pos: i, Msg: "invalid UTF-8 encoding"}
+		case unicode.IsSpace(c):
+			i += width
+		case c == '(':
+			lexemes = append(lexemes, lexeme{kind: lexLeftParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			lexemes = append(lexemes, lexeme{kind: lexRightParen, text: ")", pos: i})
+			i++
+		case c == ',':
+			lexemes = append(lexemes, lexeme{kind: lexComma, text: ",", pos: i})
+			i++
+		case unicode.IsLetter(c) || c == '_':
+			n := scanIdent(expr[i:])
+			lexemes = append(lexemes, lexeme{kind: lexIdent, text: expr[i : i+n], pos: i})
+			i += n
+		case isDigit(c) || c == '.':
+			n := scanNumber(expr[i:])
+			if _, err := strconv.ParseFloat(expr[i:i+n], 64); err != nil {
+				return nil, &ParseError{Pos: i, Msg: fmt.Sprintf("invalid number %q", expr[i:i+n])}
+			}
+			lexemes = append(lexemes, lexeme{kind: lexNumber, text: expr[i : i+n], pos: i})
+			i += n
+		default:
+			sym := matchOperator(expr[i:])
+			if sym == "" {
+				return nil, &ParseError{Pos: i, Msg: fmt.Sprintf("unexpected character %q", c)}
+			}
+			lexemes = append(lexemes, lexeme{kind: lexOperator, text: sym, pos: i})
+			i += len(sym)
+		}
+	}
+
+	return append(lexemes, lexeme{kind: lexEOF, pos: len(expr)}), nil
+}
+
+// scanNumber returns the length of the numeric literal at the start of s. Only ASCII digits are recognized as they
+// are the only ones strconv accepts.
+func scanNumber(s string) int {
+	n := 0
+	for n < len(s) && (isDigit(rune(s[n])) || s[n] == '.') {
+		n++
+	}
+
+	// exponent
+	if n < len(s) && (s[n] == 'e' || s[n] == 'E') {
+		m := n + 1
+		if m < len(s) && (s[m] == '+' || s[m] == '-') {
+			m++
+		}
+
+		if m < len(s) && isDigit(rune(s[m])) {
+			for m < len(s) && isDigit(rune(s[m])) {
+				m++
+			}
+			n = m
+		}
+	}
+
+	return n
+}
+
+// scanIdent returns the length in bytes of the identifier at the start of s.
+func scanIdent(s string) int {
+	n := 0
+	for n < len(s) {
+		c, width := utf8.DecodeRuneInString(s[n:])
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			break
+		}
+		n += width
+	}
+
+	return n
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+// matchOperator returns the longest operator symbol that prefixes s.
+func matchOperator(s string) string {
+	var match string
+	for sym := range infixOperators {
+		if len(sym) > len(match) && strings.HasPrefix(s, sym) {
+			match = sym
+		}
+	}
+
+	return match
+}
+
+// infixParser converts an infix expression to RPN tokens using precedence climbing.
+type infixParser struct {
+	lexemes   []lexeme
+	next      int
+	out       []*v1pb.Token
+	positions []int
+	depth     int
+}
+
+// parseInfix parses an infix expression such as "(5 + 8) * 3 - 2" into the equivalent RPN token stream.
+func parseInfix(expr string) ([]*v1pb.Token, error) {
+	tokens, _, err := parseInfixPositions(expr)
+	return tokens, err
+}
+
+// parseInfixPositions is like parseInfix but also returns the byte offset in expr of the symbol that produced
+// each token.
+func parseInfixPositions(expr string) ([]*v1pb.Token, []int, error) {
+	lexemes, err := lex(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := &infixParser{lexemes: lexemes}
+	if err := p.parseExpr(1); err != nil {
+		return nil, nil, err
+	}
+
+	if l := p.peek(); l.kind != lexEOF {
+		return nil, nil, &ParseError{Pos: l.pos, Msg: fmt.Sprintf("unexpected %q", l.text)}
+	}
+
+	return p.out, p.positions, nil
+}
+
+func (p *infixParser) peek() lexeme {
+	return p.lexemes[p.next]
+}
+
+func (p *infixParser) advance() lexeme {
+	l := p.lexemes[p.next]
+	if l.kind != lexEOF {
+		p.next++
+	}
+	return l
+}
+
+func (p *infixParser) parseExpr(minPrecedence int) error {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxInfixDepth {
+		return &ParseError{Pos: p.peek().pos, Msg: fmt.Sprintf("expression is nested deeper than %d levels", maxInfixDepth)}
+	}
+
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+
+	for {
+		l := p.peek()
+		if l.kind != lexOperator {
+			return nil
+		}
+
+		bop := infixOperators[l.text]
+		if bop.precedence < minPrecedence {
+			return nil
+		}
+		p.advance()
+
+		nextPrecedence := bop.precedence + 1
+		if bop.rightAssoc {
+			nextPrecedence = bop.precedence
+		}
+
+		if err := p.parseExpr(nextPrecedence); err != nil {
+			return err
+		}
+
+		p.emit(&v1pb.Token{Token: &v1pb.Token_Operator{Operator: bop.op}}, l.pos)
+	}
+}
+
+func (p *infixParser) parseUnary() error {
+	l := p.peek()
+	if l.kind == lexOperator && (l.text == "-" || l.text == "+") {
+		p.advance()
+		if err := p.parseExpr(unaryPrecedence); err != nil {
+			return err
+		}
+
+		if l.text == "-" {
+			p.emit(&v1pb.Token{Token: &v1pb.Token_Function{Function: &v1pb.Function{Name: "neg"}}}, l.pos)
+		}
+		return nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *infixParser) parsePrimary() error {
+	l := p.advance()
+	switch l.kind {
+	case lexNumber:
+		v, err := strconv.ParseFloat(l.text, 64)
+		if err != nil {
+			return &ParseError{Pos: l.pos, Msg: fmt.Sprintf("invalid number %q", l.text)}
+		}
+		p.emit(&v1pb.Token{Token: &v1pb.Token_Operand{Operand: &v1pb.Operand{Value: v, Decimal: l.text}}}, l.pos)
+		return nil
+	case lexLeftParen:
+		if err := p.parseExpr(1); err != nil {
+			return err
+		}
+
+		if r := p.advance(); r.kind != lexRightParen {
+			return &ParseError{Pos: r.pos, Msg: fmt.Sprintf("missing closing parenthesis for the one at position %d", l.pos)}
+		}
+		return nil
+	case lexIdent:
+		return p.parseCall(l)
+	case lexEOF:
+		return &ParseError{Pos: l.pos, Msg: "unexpected end of expression"}
+	default:
+		return &ParseError{Pos: l.pos, Msg: fmt.Sprintf("unexpected %q", l.text)}
+	}
+}
+
+// parseCall parses a function call such as "max(1, 2, 3)". Functions without arguments may omit the parentheses.
+// Identifiers that do not name a function and are not followed by parentheses are variables.
+func (p *infixParser) parseCall(ident lexeme) error {
+	name := strings.ToLower(ident.text)
+	fn, ok := functions[name]
+	if !ok {
+		if p.peek().kind == lexLeftParen {
+			return &ParseError{Pos: ident.pos, Msg: fmt.Sprintf("unknown function %q", ident.text)}
+		}
+
+		p.emit(&v1pb.Token{Token: &v1pb.Token_Variable{Variable: &v1pb.Variable{Name: ident.text}}}, ident.pos)
+		return nil
+	}
+
+	argc := 0
+	if p.peek().kind == lexLeftParen {
+		open := p.advance()
+		if p.peek().kind == lexRightParen {
+			p.advance()
+		} else {
+			for {
+				if err := p.parseExpr(1); err != nil {
+					return err
+				}
+				argc++
+
+				r := p.advance()
+				if r.kind == lexRightParen {
+					break
+				}
+
+				if r.kind != lexComma {
+					return &ParseError{Pos: r.pos, Msg: fmt.Sprintf("missing closing parenthesis for the one at position %d", open.pos)}
+				}
+			}
+		}
+	}
+
+	fnTok := &v1pb.Function{Name: name}
+	switch {
+	case fn.arity == variadic && argc == 0:
+		return &ParseError{Pos: ident.pos, Msg: fmt.Sprintf("%s requires at least one argument", name)}
+	case fn.arity == variadic:
+		fnTok.Arity = int32(argc)
+	case fn.arity != argc:
+		return &ParseError{Pos: ident.pos, Msg: fmt.Sprintf("%s takes %d arguments but %d were given", name, fn.arity, argc)}
+	}
+
+	p.emit(&v1pb.Token{Token: &v1pb.Token_Function{Function: fnTok}}, ident.pos)
+	return nil
+}
+
+func (p *infixParser) emit(tok *v1pb.Token, pos int) {
+	p.out = append(p.out, tok)
+	p.positions = append(p.positions, pos)
+}
@@ -0,0 +1,103 @@
+package calculator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInfix(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expr       string
+		wantResult float64
+	}{
+		{name: "single", expr: "42", wantResult: 42},
+		{name: "precedence", expr: "5 + 8 * 3 - 2", wantResult: 27},
+		{name: "parentheses", expr: "(5 + 8) * 3 - 2", wantResult: 37},
+		{name: "leftAssoc", expr: "100 / 10 / 5", wantResult: 2},
+		{name: "leftAssocSubtract", expr: "10 - 4 - 3", wantResult: 3},
+		{name: "unaryMinus", expr: "-3 * -(2 + 1)", wantResult: 9},
+		{name: "unaryPlus", expr: "+3 - +1", wantResult: 2},
+		{name: "doubleNegation", expr: "--4", wantResult: 4},
+		{name: "noSpaces", expr: "2*(3+4)", wantResult: 14},
+		{name: "decimals", expr: ".5 + 1.25e1", wantResult: 13},
+		{name: "nested", expr: "((((1))))", wantResult: 1},
+		{name: "pow", expr: "2 ^ 3 * 2", wantResult: 16},
+		{name: "powRightAssoc", expr: "2 ^ 3 ^ 2", wantResult: 512},
+		{name: "powUnaryMinus", expr: "-2 ^ 2", wantResult: -4},
+		{name: "powNegativeExponent", expr: "2 ^ -1", wantResult: 0.5},
+		{name: "modAndIdiv", expr: "17 // 5 + 17 % 5", wantResult: 5},
+		{name: "idivNotDivide", expr: "9//2/2", wantResult: 2},
+		{name: "function", expr: "sqrt(16) + 1", wantResult: 5},
+		{name: "nestedFunctions", expr: "abs(min(-3, 2 * -4, 1))", wantResult: 8},
+		{name: "binaryFunction", expr: "hypot(3, 4)", wantResult: 5},
+		{name: "constant", expr: "floor(pi * 100)", wantResult: 314},
+		{name: "constantWithParens", expr: "round(e())", wantResult: 3},
+		{name: "caseInsensitive", expr: "MAX(1, 5, 3)", wantResult: 5},
+		{name: "variables", expr: "x ^ 2 + max(x, y_1)", wantResult: 13},
+		{name: "unicodeIdentifier", expr: "größe - x", wantResult: 2},
+		{name: "unicodeSpace", expr: "1\u00a0+\u20032", wantResult: 3},
+		{name: "deeplyNested", expr: strings.Repeat("(", 200) + "1" + strings.Repeat(")", 200), wantResult: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := parseInfix(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantResult, evaluateTokens(t, tokens))
+		})
+	}
+}
+
+func TestParseInfixErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		expr    string
+		wantPos int
+	}{
+		{name: "empty", expr: "", wantPos: 0},
+		{name: "danglingOperator", expr: "5 +", wantPos: 3},
+		{name: "unknownCharacter", expr: "5 $ 3", wantPos: 2},
+		{name: "unknownMultiByteCharacter", expr: "5 € 3", wantPos: 2},
+		{name: "invalidUTF8", expr: "1 + \xc3", wantPos: 4},
+		{name: "invalidUTF8InIdentifier", expr: "x\xc3 + 1", wantPos: 1},
+		{name: "nonASCIIDigit", expr: "1 + \u0663", wantPos: 4},
+		{name: "unclosedParen", expr: "(5 + 3", wantPos: 6},
+		{name: "unopenedParen", expr: "5 + 3)", wantPos: 5},
+		{name: "missingOperator", expr: "5 3", wantPos: 2},
+		{name: "invalidNumber", expr: "1 + 1.2.3", wantPos: 4},
+		{name: "emptyParens", expr: "2 * ()", wantPos: 5},
+		{name: "unknownFunction", expr: "1 + foo(2)", wantPos: 4},
+		{name: "wrongArity", expr: "sqrt(1, 2)", wantPos: 0},
+		{name: "variadicWithoutArgs", expr: "2 * max()", wantPos: 4},
+		{name: "missingArguments", expr: "sqrt + 1", wantPos: 0},
+		{name: "unclosedCall", expr: "max(1, 2", wantPos: 8},
+		{name: "nestedTooDeep", expr: strings.Repeat("(", 300) + "1" + strings.Repeat(")", 300), wantPos: 256},
+		{name: "unaryTooDeep", expr: strings.Repeat("-", 300) + "1", wantPos: 256},
+		{name: "callsTooDeep", expr: strings.Repeat("abs(", 300) + "1" + strings.Repeat(")", 300), wantPos: 1024},
+		{name: "powTooDeep", expr: "2" + strings.Repeat("^2", 300), wantPos: 512},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseInfix(tc.expr)
+			require.Error(t, err)
+
+			perr, ok := err.(*ParseError)
+			require.True(t, ok, "expected *ParseError, got %T", err)
+			require.Equal(t, tc.wantPos, perr.Pos)
+		})
+	}
+}
+
+func evaluateTokens(t *testing.T, tokens []*v1pb.Token) float64 {
+	t.Helper()
+
+	bindings := map[string]*v1pb.Operand{"x": {Value: 3}, "y_1": {Value: 4}, "größe": {Value: 5}}
+	result, err := evaluate(&rpnEvaluator{bindings: bindings}, tokens)
+	require.NoError(t, err)
+	return result.(float64)
+}
@@ -0,0 +1,231 @@
+package calculator
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JSON-RPC 2.0 error codes. See https://www.jsonrpc.org/specification#error_object
+const (
+	jsonrpcParseError     = -32700
+	jsonrpcInvalidRequest = -32600
+	jsonrpcMethodNotFound = -32601
+	jsonrpcInvalidParams  = -32602
+	jsonrpcInternalError  = -32603
+	// jsonrpcResourceExhausted is in the range reserved for implementation-defined server errors
+	jsonrpcResourceExhausted = -32000
+)
+
+// jsonrpcMethodPrefix is prepended to the lowerCamelCase name of the RPC to obtain the JSON-RPC method name.
+const jsonrpcMethodPrefix = "calculator."
+
+type jsonrpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	// ID is nil for notifications, which are not answered
+	ID json.RawMessage `json:"id"`
+}
+
+type jsonrpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	// Data holds the status returned by the service, including the error details
+	Data *httpErrorBody `json:"data,omitempty"`
+}
+
+// NewJSONRPCHandler exposes the evaluation RPCs of the service as JSON-RPC 2.0 methods over HTTP POST requests. The
+// methods are named after the RPCs, such as calculator.evaluateBatch, and take the protobuf JSON form of the RPC
+// request as their named parameters. Batch calls are supported. Request bodies larger than maxBodySize are rejected.
+func NewJSONRPCHandler(svc *Service, maxBodySize int64) http.Handler {
+	methods := make(map[string]httpRoute, len(httpRoutes))
+	for _, route := range httpRoutes {
+		methods[jsonrpcMethodPrefix+strings.ToLower(route.rpc[:1])+route.rpc[1:]] = route
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body := r.Body
+		if maxBodySize > 0 {
+			body = http.MaxBytesReader(w, body, maxBodySize)
+		}
+
+		buf, err := ioutil.ReadAll(body)
+		body.Close()
+		if err != nil {
+			writeJSONRPC(w, jsonrpcErrorResponse(nil, jsonrpcParseError, "failed to read request: "+err.Error()))
+			return
+		}
+
+		h := &jsonrpcHandler{svc: svc, methods: methods, r: r}
+		if resp := h.handle(buf); resp != nil {
+			writeJSONRPC(w, resp)
+			return
+		}
+
+		// the request only held notifications
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+type jsonrpcHandler struct {
+	svc     *Service
+	methods map[string]httpRoute
+	r       *http.Request
+}
+
+// handle returns the response to a single or batch call, or nil if there is nothing to answer.
+func (h *jsonrpcHandler) handle(buf []byte) interface{} {
+	if !json.Valid(buf) {
+		return jsonrpcErrorResponse(nil, jsonrpcParseError, "parse error: invalid JSON")
+	}
+
+	buf = bytes.TrimSpace(buf)
+	if buf[0] != '[' {
+		var req jsonrpcRequest
+		if err := json.Unmarshal(buf, &req); err != nil {
+			return jsonrpcErrorResponse(nil, jsonrpcInvalidRequest, "invalid request: "+err.Error())
+		}
+
+		// a nil response must not be returned as a non-nil interface
+		if resp := h.call(&req); resp != nil {
+			return resp
+		}
+		return nil
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(buf, &batch); err != nil {
+		return jsonrpcErrorResponse(nil, jsonrpcInvalidRequest, "invalid request: "+err.Error())
+	}
+
+	if len(batch) == 0 {
+		return jsonrpcErrorResponse(nil, jsonrpcInvalidRequest, "empty batch")
+	}
+
+	var responses []*jsonrpcResponse
+	for _, raw := range batch {
+		var resp *jsonrpcResponse
+		var req jsonrpcRequest
+		if err := json.Unmarshal(raw, &req); err != nil {
+			resp = jsonrpcErrorResponse(nil, jsonrpcInvalidRequest, "invalid request: "+err.Error())
+		} else {
+			resp = h.call(&req)
+		}
+
+		if resp != nil {
+			responses = append(responses, resp)
+		}
+	}
+
+	if len(responses) == 0 {
+		return nil
+	}
+
+	return responses
+}
+
+// call invokes the method of the request. It returns nil for notifications.
+func (h *jsonrpcHandler) call(req *jsonrpcRequest) *jsonrpcResponse {
+	if id := bytes.TrimSpace(req.ID); len(id) > 0 && (id[0] == '{' || id[0] == '[' || id[0] == 't' || id[0] == 'f') {
+		return jsonrpcErrorResponse(nil, jsonrpcInvalidRequest, "invalid request: id must be a string, a number or null")
+	}
+
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return jsonrpcErrorResponse(req.ID, jsonrpcInvalidRequest, `invalid request: jsonrpc must be "2.0" and method must be set`)
+	}
+
+	var resp *jsonrpcResponse
+	if route, ok := h.methods[req.Method]; ok {
+		resp = h.invoke(req, route)
+	} else {
+		resp = jsonrpcErrorResponse(req.ID, jsonrpcMethodNotFound, "method not found: "+req.Method)
+	}
+
+	if req.ID == nil {
+		return nil
+	}
+
+	return resp
+}
+
+func (h *jsonrpcHandler) invoke(req *jsonrpcRequest, route httpRoute) *jsonrpcResponse {
+	rpcReq := route.newRequest()
+	params := bytes.TrimSpace(req.Params)
+	if len(params) > 0 && !bytes.Equal(params, []byte("null")) {
+		if params[0] != '{' {
+			return jsonrpcErrorResponse(req.ID, jsonrpcInvalidParams, "params must be an object")
+		}
+
+		if err := jsonpb.Unmarshal(bytes.NewReader(params), rpcReq); err != nil {
+			return jsonrpcErrorResponse(req.ID, jsonrpcInvalidParams, "invalid params: "+err.Error())
+		}
+	}
+
+	rpcResp, err := route.call(httpContext(h.r), h.svc, rpcReq)
+	if err != nil {
+		st := status.Convert(err)
+		resp := jsonrpcErrorResponse(req.ID, jsonrpcCode(st.Code()), st.Message())
+		resp.Error.Data = &httpErrorBody{Code: st.Code(), Message: st.Message(), Details: statusDetailsJSON(st)}
+		return resp
+	}
+
+	result, err := jsonMarshaler.MarshalToString(rpcResp)
+	if err != nil {
+		return jsonrpcErrorResponse(req.ID, jsonrpcInternalError, "failed to encode result: "+err.Error())
+	}
+
+	return &jsonrpcResponse{JSONRPC: "2.0", Result: json.RawMessage(result), ID: req.ID}
+}
+
+// jsonrpcCode returns the JSON-RPC error code corresponding to a gRPC code.
+func jsonrpcCode(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return jsonrpcInvalidParams
+	case codes.ResourceExhausted:
+		return jsonrpcResourceExhausted
+	default:
+		return jsonrpcInternalError
+	}
+}
+
+func jsonrpcErrorResponse(id json.RawMessage, code int, msg string) *jsonrpcResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+
+	return &jsonrpcResponse{JSONRPC: "2.0", Error: &jsonrpcError{Code: code, Message: msg}, ID: id}
+}
+
+func writeJSONRPC(w http.ResponseWriter, resp interface{}) {
+	buf, err := json.Marshal(resp)
+	if err != nil {
+		zap.S().Warnw("Failed to encode JSON-RPC response", "error", err)
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(buf)
+}
@@ -0,0 +1,114 @@
+package calculator
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONRPC(t *testing.T) {
+	srv := httptest.NewServer(NewJSONRPCHandler(NewService(WithMaxStackDepth(2)), 1<<20))
+	defer srv.Close()
+
+	testCases := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "evaluateBatch",
+			body:       `{"jsonrpc": "2.0", "method": "calculator.evaluateBatch", "params": {"tokens": [{"operand": {"value": 5}}, {"operand": {"value": 8}}, {"operator": "ADD"}]}, "id": 1}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc": "2.0", "result": {"result": 13, "resultDecimal": "13", "resultFraction": "", "trace": null}, "id": 1}`,
+		},
+		{
+			name:       "evaluateExpression",
+			body:       `{"jsonrpc": "2.0", "method": "calculator.evaluateExpression", "params": {"expression": "(5 + 8) * 3", "notation": "INFIX"}, "id": "a"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc": "2.0", "result": {"result": 39}, "id": "a"}`,
+		},
+		{
+			name:       "invalidArgument",
+			body:       `{"jsonrpc": "2.0", "method": "calculator.evaluateExpression", "params": {"expression": "1 +"}, "id": 2}`,
+			wantStatus: http.StatusOK,
+			wantBody: `{"jsonrpc": "2.0", "error": {"code": -32602, "message": "not enough operands", "data": {"code": 3, "message": "not enough operands", "details": [
+				{"@type": "type.googleapis.com/com.github.charithe.calculator.v1.EvaluationError", "reason": "NOT_ENOUGH_OPERANDS", "tokenIndex": 1, "token": "+", "stackDepth": 1},
+				{"@type": "type.googleapis.com/google.rpc.BadRequest", "fieldViolations": [{"field": "tokens[1]", "description": "not enough operands"}]}
+			]}}, "id": 2}`,
+		},
+		{
+			name:       "resourceExhausted",
+			body:       `{"jsonrpc": "2.0", "method": "calculator.evaluateExpression", "params": {"expression": "1 2 3 + +"}, "id": 3}`,
+			wantStatus: http.StatusOK,
+			wantBody: `{"jsonrpc": "2.0", "error": {"code": -32000, "message": "stack full: maximum depth of 2 exceeded", "data": {"code": 8, "message": "stack full: maximum depth of 2 exceeded", "details": [
+				{"@type": "type.googleapis.com/com.github.charithe.calculator.v1.EvaluationError", "reason": "STACK_FULL", "tokenIndex": 2, "token": "3", "stackDepth": 2},
+				{"@type": "type.googleapis.com/google.rpc.BadRequest", "fieldViolations": [{"field": "tokens[2]", "description": "stack full: maximum depth of 2 exceeded"}]},
+				{"@type": "type.googleapis.com/com.github.charithe.calculator.v1.StackDepthExceeded", "limit": 2}
+			]}}, "id": 3}`,
+		},
+		{
+			name: "batch",
+			body: `[
+				{"jsonrpc": "2.0", "method": "calculator.evaluateExpression", "params": {"expression": "1 2 +"}, "id": 1},
+				{"jsonrpc": "2.0", "method": "calculator.evaluateExpression", "params": {"expression": "1 2"}},
+				{"jsonrpc": "2.0", "method": "calculator.unknown", "id": 2},
+				{"jsonrpc": "1.0", "method": "calculator.evaluateExpression", "id": 3},
+				1
+			]`,
+			wantStatus: http.StatusOK,
+			wantBody: `[
+				{"jsonrpc": "2.0", "result": {"result": 3}, "id": 1},
+				{"jsonrpc": "2.0", "error": {"code": -32601, "message": "method not found: calculator.unknown"}, "id": 2},
+				{"jsonrpc": "2.0", "error": {"code": -32600, "message": "invalid request: jsonrpc must be \"2.0\" and method must be set"}, "id": 3},
+				{"jsonrpc": "2.0", "error": {"code": -32600, "message": "invalid request: json: cannot unmarshal number into Go value of type calculator.jsonrpcRequest"}, "id": null}
+			]`,
+		},
+		{
+			name:       "notifications",
+			body:       `[{"jsonrpc": "2.0", "method": "calculator.evaluateExpression", "params": {"expression": "1"}}]`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "emptyBatch",
+			body:       `[]`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc": "2.0", "error": {"code": -32600, "message": "empty batch"}, "id": null}`,
+		},
+		{
+			name:       "parseError",
+			body:       `{"jsonrpc": "2.0", "method"`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc": "2.0", "error": {"code": -32700, "message": "parse error: invalid JSON"}, "id": null}`,
+		},
+		{
+			name:       "positionalParams",
+			body:       `{"jsonrpc": "2.0", "method": "calculator.evaluateExpression", "params": ["1 2 +"], "id": 4}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc": "2.0", "error": {"code": -32602, "message": "params must be an object"}, "id": 4}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStatus, resp.StatusCode, "%s", body)
+
+			if tc.wantBody == "" {
+				require.Empty(t, body)
+				return
+			}
+			require.JSONEq(t, tc.wantBody, string(body))
+		})
+	}
+}
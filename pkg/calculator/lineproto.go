@@ -0,0 +1,212 @@
+package calculator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultLineIdleTimeout is how long a line protocol connection is kept open without receiving a line.
+const DefaultLineIdleTimeout = 5 * time.Minute
+
+// DefaultMaxLineLength is the maximum length of a line protocol request in bytes.
+const DefaultMaxLineLength = 4096
+
+var errLineTooLong = errors.New("line too long")
+
+// LineServer serves a plain-text RPN protocol in the style of dc, intended for scripts and tools such as nc.
+// Each connection has its own stack, to which the whitespace separated tokens of every line are applied in order.
+// Besides the usual tokens, the following commands are understood:
+//
+//	p  print the value at the top of the stack
+//	f  print the whole stack, one value per line, top first
+//	q  close the connection
+//
+// Failures are reported as a line starting with "error: ". The failing token leaves the stack unchanged and the rest of
+// the line is ignored.
+type LineServer struct {
+	svc           *Service
+	idleTimeout   time.Duration
+	maxLineLength int
+
+	mu       sync.Mutex
+	closed   bool
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+}
+
+// NewLineServer creates a line protocol server. Connections that do not send a line for idleTimeout are closed, as are
+// connections sending lines longer than maxLineLength bytes. Zero values disable the limits.
+func NewLineServer(svc *Service, idleTimeout time.Duration, maxLineLength int) *LineServer {
+	return &LineServer{
+		svc:           svc,
+		idleTimeout:   idleTimeout,
+		maxLineLength: maxLineLength,
+		conns:         make(map[net.Conn]struct{}),
+	}
+}
+
+// Serve accepts connections from the listener until the server is closed, in which case it returns nil.
+func (l *LineServer) Serve(lis net.Listener) error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil
+	}
+	l.listener = lis
+	l.mu.Unlock()
+
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			l.mu.Lock()
+			closed := l.closed
+			l.mu.Unlock()
+			if closed {
+				return nil
+			}
+
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				zap.S().Warnw("Failed to accept line protocol connection", "error", err)
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			return err
+		}
+
+		if !l.track(conn) {
+			conn.Close()
+			return nil
+		}
+		go l.serveConn(conn)
+	}
+}
+
+// Close stops accepting connections and closes the open connections.
+func (l *LineServer) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.closed = true
+	for conn := range l.conns {
+		conn.Close()
+	}
+
+	if l.listener == nil {
+		return nil
+	}
+	return l.listener.Close()
+}
+
+// track adds the connection to the set of open connections and returns false if the server is closed.
+func (l *LineServer) track(conn net.Conn) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return false
+	}
+	l.conns[conn] = struct{}{}
+	return true
+}
+
+func (l *LineServer) untrack(conn net.Conn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.conns, conn)
+}
+
+func (l *LineServer) serveConn(conn net.Conn) {
+	defer l.untrack(conn)
+	defer conn.Close()
+
+	rpn := &rpnEvaluator{maxDepth: l.svc.stackDepth(0)}
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	for {
+		if l.idleTimeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(l.idleTimeout))
+		}
+
+		line, err := readLine(r, l.maxLineLength)
+		if err == errLineTooLong {
+			fmt.Fprintf(w, "error: line exceeds %d bytes\n", l.maxLineLength)
+			w.Flush()
+			return
+		}
+
+		if err != nil {
+			if err != io.EOF {
+				zap.S().Debugw("Closing line protocol connection", "remote", conn.RemoteAddr(), "error", err)
+			}
+			return
+		}
+
+		quit := evaluateLine(rpn, line, w)
+		if err := w.Flush(); err != nil || quit {
+			return
+		}
+	}
+}
+
+// readLine returns the next line without the line terminator. Lines longer than maxLength bytes are rejected without
+// reading all of them into memory.
+func readLine(r *bufio.Reader, maxLength int) (string, error) {
+	var line []byte
+	for {
+		frag, err := r.ReadSlice('\n')
+		line = append(line, frag...)
+		// allow for a CRLF terminator
+		if maxLength > 0 && len(strings.TrimRight(string(line), "\r\n")) > maxLength {
+			return "", errLineTooLong
+		}
+
+		switch {
+		case err == bufio.ErrBufferFull:
+			continue
+		case err == io.EOF && len(line) > 0:
+			// the last line is not terminated
+		case err != nil:
+			return "", err
+		}
+
+		return strings.TrimRight(string(line), "\r\n"), nil
+	}
+}
+
+// evaluateLine applies the tokens and commands of the line and writes the output to w. It returns true if the client
+// asked to close the connection.
+func evaluateLine(rpn *rpnEvaluator, line string, w io.Writer) bool {
+	for _, word := range strings.Fields(line) {
+		switch word {
+		case "p":
+			stack := rpn.formatStack()
+			if len(stack) == 0 {
+				fmt.Fprintln(w, "error: stack empty")
+				return false
+			}
+			fmt.Fprintln(w, stack[len(stack)-1])
+		case "f":
+			stack := rpn.formatStack()
+			for i := len(stack) - 1; i >= 0; i-- {
+				fmt.Fprintln(w, stack[i])
+			}
+		case "q":
+			return true
+		default:
+			if err := applyTokenString(rpn, word); err != nil {
+				fmt.Fprintf(w, "error: %s: %s\n", word, err.Message)
+				return false
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,109 @@
+package calculator
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	srv := NewLineServer(NewService(WithMaxStackDepth(3)), 200*time.Millisecond, 32)
+	go srv.Serve(lis)
+	defer srv.Close()
+
+	t.Run("session", func(t *testing.T) {
+		conn, err := net.Dial("tcp", lis.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		testCases := []struct {
+			line    string
+			wantOut []string
+		}{
+			{line: "5 8 + p", wantOut: []string{"13"}},
+			{line: "2 * p", wantOut: []string{"26"}},
+			{line: "+ p", wantOut: []string{"error: +: not enough operands"}},
+			{line: "1 2 3 p", wantOut: []string{"error: 3: stack full: maximum depth of 3 exceeded"}},
+			{line: "f\r", wantOut: []string{"2", "1", "26"}},
+			{line: "clear p", wantOut: []string{"error: stack empty"}},
+			{line: "2 sqrt sto:a dup * p", wantOut: []string{"2.0000000000000004"}},
+			{line: "foo( p", wantOut: []string{`error: foo(: strconv.ParseFloat: parsing "foo(": invalid syntax`}},
+		}
+
+		for _, tc := range testCases {
+			_, err := conn.Write([]byte(tc.line + "\n"))
+			require.NoError(t, err)
+
+			for _, want := range tc.wantOut {
+				have, err := r.ReadString('\n')
+				require.NoError(t, err, tc.line)
+				require.Equal(t, want+"\n", have, tc.line)
+			}
+		}
+
+		_, err = conn.Write([]byte("1 p q 2 p\n"))
+		require.NoError(t, err)
+		out, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "1\n", string(out))
+	})
+
+	t.Run("lineTooLong", func(t *testing.T) {
+		conn, err := net.Dial("tcp", lis.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = conn.Write([]byte(strings.Repeat("1 ", 20) + "\n"))
+		require.NoError(t, err)
+
+		out, err := ioutil.ReadAll(conn)
+		require.NoError(t, err)
+		require.Equal(t, "error: line exceeds 32 bytes\n", string(out))
+	})
+
+	t.Run("idleTimeout", func(t *testing.T) {
+		conn, err := net.Dial("tcp", lis.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		start := time.Now()
+		out, err := ioutil.ReadAll(conn)
+		require.NoError(t, err)
+		require.Empty(t, out)
+		require.True(t, time.Since(start) >= 200*time.Millisecond)
+	})
+
+	t.Run("unixSocket", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "line")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "calc.sock")
+		unixLis, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		go srv.Serve(unixLis)
+		defer unixLis.Close()
+
+		conn, err := net.Dial("unix", path)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = conn.Write([]byte("3 4 ^ p\n"))
+		require.NoError(t, err)
+
+		have, err := bufio.NewReader(conn).ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "81\n", have)
+	})
+}
@@ -0,0 +1,41 @@
+package calculator
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	programCacheHits      = stats.Int64("calculator/program_cache/hits", "Number of Execute calls that found the program in the cache", stats.UnitDimensionless)
+	programCacheMisses    = stats.Int64("calculator/program_cache/misses", "Number of Execute calls for programs missing from the cache", stats.UnitDimensionless)
+	programCacheEvictions = stats.Int64("calculator/program_cache/evictions", "Number of programs evicted from the cache", stats.UnitDimensionless)
+	programCacheEntries   = stats.Int64("calculator/program_cache/entries", "Number of programs in the cache", stats.UnitDimensionless)
+)
+
+// ProgramCacheViews are the views of the program cache metrics. Register them to export the metrics.
+var ProgramCacheViews = []*view.View{
+	{
+		Name:        "calculator/program_cache/hits",
+		Description: programCacheHits.Description(),
+		Measure:     programCacheHits,
+		Aggregation: view.Count(),
+	},
+	{
+		Name:        "calculator/program_cache/misses",
+		Description: programCacheMisses.Description(),
+		Measure:     programCacheMisses,
+		Aggregation: view.Count(),
+	},
+	{
+		Name:        "calculator/program_cache/evictions",
+		Description: programCacheEvictions.Description(),
+		Measure:     programCacheEvictions,
+		Aggregation: view.Count(),
+	},
+	{
+		Name:        "calculator/program_cache/entries",
+		Description: programCacheEntries.Description(),
+		Measure:     programCacheEntries,
+		Aggregation: view.LastValue(),
+	},
+}
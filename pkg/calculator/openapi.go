@@ -0,0 +1,264 @@
+package calculator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// OpenAPISpec generates an OpenAPI 3 document describing the JSON endpoints of the HTTP handler from the protobuf
+// descriptors of the request and response messages.
+func OpenAPISpec() ([]byte, error) {
+	g := &openAPIGenerator{schemas: make(map[string]interface{})}
+	paths := make(map[string]interface{}, len(httpRoutes))
+	for _, route := range httpRoutes {
+		reqRef, err := g.messageRef(route.newRequest())
+		if err != nil {
+			return nil, err
+		}
+
+		respRef, err := g.messageRef(route.newResponse())
+		if err != nil {
+			return nil, err
+		}
+
+		paths[route.path] = map[string]interface{}{
+			"post": map[string]interface{}{
+				"operationId": route.rpc,
+				"summary":     route.summary,
+				"requestBody": map[string]interface{}{
+					"required": true,
+					"content":  jsonContent(reqRef),
+				},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "Successful evaluation",
+						"content":     jsonContent(respRef),
+					},
+					"default": map[string]interface{}{
+						"description": "Failed evaluation. The HTTP status code corresponds to the gRPC code of the status.",
+						"content":     jsonContent(schemaRef("Status")),
+					},
+				},
+			},
+		}
+	}
+
+	g.schemas["Status"] = map[string]interface{}{
+		"type":        "object",
+		"description": "JSON form of google.rpc.Status",
+		"properties": map[string]interface{}{
+			"code":    map[string]interface{}{"type": "integer", "format": "int32", "description": "gRPC status code"},
+			"message": map[string]interface{}{"type": "string"},
+			"details": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type":                 "object",
+					"description":          "error detail with its type URL in the @type field",
+					"properties":           map[string]interface{}{"@type": map[string]interface{}{"type": "string"}},
+					"additionalProperties": true,
+				},
+			},
+		},
+	}
+
+	spec := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "Calculator",
+			"version": "v1",
+		},
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": g.schemas},
+	}
+
+	buf, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buf, '\n'), nil
+}
+
+// openAPIGenerator converts message and enum descriptors to schemas following the protobuf JSON mapping.
+type openAPIGenerator struct {
+	file    *descriptor.FileDescriptorProto
+	schemas map[string]interface{}
+}
+
+func (g *openAPIGenerator) messageRef(msg proto.Message) (map[string]interface{}, error) {
+	dm, ok := msg.(descriptor.Message)
+	if !ok {
+		return nil, fmt.Errorf("message %s has no descriptor", proto.MessageName(msg))
+	}
+
+	fd, md := descriptor.ForMessage(dm)
+	if g.file == nil {
+		g.file = fd
+	}
+
+	name := "." + fd.GetPackage() + "." + md.GetName()
+	if err := g.addMessage(name); err != nil {
+		return nil, err
+	}
+
+	return schemaRef(g.schemaName(name)), nil
+}
+
+// schemaName strips the package from a fully qualified type name and joins the names of nested types.
+func (g *openAPIGenerator) schemaName(typeName string) string {
+	name := strings.TrimPrefix(typeName, "."+g.file.GetPackage()+".")
+	return strings.Replace(name, ".", "", -1)
+}
+
+// lookup finds a message or enum of the file by its fully qualified name.
+func (g *openAPIGenerator) lookup(typeName string) (*descriptor.DescriptorProto, *descriptor.EnumDescriptorProto) {
+	path := strings.Split(strings.TrimPrefix(typeName, "."+g.file.GetPackage()+"."), ".")
+	messages, enums := g.file.GetMessageType(), g.file.GetEnumType()
+	for i, part := range path {
+		last := i == len(path)-1
+		if last {
+			for _, e := range enums {
+				if e.GetName() == part {
+					return nil, e
+				}
+			}
+		}
+
+		var found *descriptor.DescriptorProto
+		for _, m := range messages {
+			if m.GetName() == part {
+				found = m
+				break
+			}
+		}
+
+		if found == nil || last {
+			return found, nil
+		}
+		messages, enums = found.GetNestedType(), found.GetEnumType()
+	}
+
+	return nil, nil
+}
+
+func (g *openAPIGenerator) addMessage(typeName string) error {
+	name := g.schemaName(typeName)
+	if _, ok := g.schemas[name]; ok {
+		return nil
+	}
+
+	md, ed := g.lookup(typeName)
+	switch {
+	case ed != nil:
+		values := make([]string, len(ed.GetValue()))
+		for i, v := range ed.GetValue() {
+			values[i] = v.GetName()
+		}
+		g.schemas[name] = map[string]interface{}{"type": "string", "enum": values}
+		return nil
+	case md == nil:
+		return fmt.Errorf("type %s not found in %s", typeName, g.file.GetName())
+	}
+
+	properties := make(map[string]interface{}, len(md.GetField()))
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	// add the schema before its fields so that recursive messages terminate
+	g.schemas[name] = schema
+
+	oneofs := make([][]string, len(md.GetOneofDecl()))
+	for _, f := range md.GetField() {
+		fieldSchema, err := g.fieldSchema(f)
+		if err != nil {
+			return err
+		}
+
+		properties[jsonFieldName(f)] = fieldSchema
+		if f.OneofIndex != nil {
+			oneofs[f.GetOneofIndex()] = append(oneofs[f.GetOneofIndex()], jsonFieldName(f))
+		}
+	}
+
+	var notes []string
+	for _, fields := range oneofs {
+		notes = append(notes, fmt.Sprintf("Only one of %s can be set.", strings.Join(fields, ", ")))
+	}
+	if len(notes) > 0 {
+		schema["description"] = strings.Join(notes, " ")
+	}
+
+	return nil
+}
+
+func (g *openAPIGenerator) fieldSchema(f *descriptor.FieldDescriptorProto) (map[string]interface{}, error) {
+	var schema map[string]interface{}
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		schema = map[string]interface{}{"type": "number", "format": "double"}
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		schema = map[string]interface{}{"type": "number", "format": "float"}
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		schema = map[string]interface{}{"type": "integer", "format": "int32"}
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		schema = map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		// 64-bit integers are written as strings in JSON
+		schema = map[string]interface{}{"type": "string", "format": "int64"}
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		schema = map[string]interface{}{"type": "string", "format": "uint64"}
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		schema = map[string]interface{}{"type": "boolean"}
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		schema = map[string]interface{}{"type": "string"}
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		schema = map[string]interface{}{"type": "string", "format": "byte"}
+	case descriptor.FieldDescriptorProto_TYPE_ENUM, descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		md, _ := g.lookup(f.GetTypeName())
+		if md.GetOptions().GetMapEntry() {
+			// map<K, V> fields are JSON objects whose keys are always strings
+			value, err := g.fieldSchema(md.GetField()[1])
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{"type": "object", "additionalProperties": value}, nil
+		}
+
+		if err := g.addMessage(f.GetTypeName()); err != nil {
+			return nil, err
+		}
+		schema = schemaRef(g.schemaName(f.GetTypeName()))
+	default:
+		return nil, fmt.Errorf("field %s has unsupported type %s", f.GetName(), f.GetType())
+	}
+
+	if f.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return map[string]interface{}{"type": "array", "items": schema}, nil
+	}
+
+	return schema, nil
+}
+
+// jsonFieldName returns the lowerCamelCase name used for the field by the protobuf JSON mapping.
+func jsonFieldName(f *descriptor.FieldDescriptorProto) string {
+	if f.GetJsonName() != "" {
+		return f.GetJsonName()
+	}
+
+	parts := strings.Split(f.GetName(), "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.Title(parts[i])
+	}
+
+	return strings.Join(parts, "")
+}
+
+func schemaRef(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"application/json": map[string]interface{}{"schema": schema}}
+}
@@ -0,0 +1,235 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+)
+
+// parseExpression tokenizes an expression written in the given notation.
+func parseExpression(expr string, notation v1pb.Notation) ([]*v1pb.Token, error) {
+	tokens, _, err := parseExpressionPositions(expr, notation)
+	return tokens, err
+}
+
+// parseExpressionPositions is like parseExpression but also returns the byte offset of each token in expr.
+func parseExpressionPositions(expr string, notation v1pb.Notation) ([]*v1pb.Token, []int, error) {
+	switch notation {
+	case v1pb.RPN:
+		return parseRPN(expr)
+	case v1pb.INFIX:
+		return parseInfixPositions(expr)
+	default:
+		return nil, nil, fmt.Errorf("unsupported notation: %s", notation)
+	}
+}
+
+// parseRPN tokenizes a whitespace separated postfix expression such as "5 8 + 3 *" and returns the tokens along with
+// their byte offsets in expr.
+func parseRPN(expr string) ([]*v1pb.Token, []int, error) {
+	words, positions := splitRPN(expr)
+	tokens := make([]*v1pb.Token, len(words))
+	for i, word := range words {
+		tok, err := parseToken(word)
+		if err != nil {
+			return nil, nil, &ParseError{Pos: positions[i], Msg: fmt.Sprintf("invalid token %q", word)}
+		}
+		tokens[i] = tok
+	}
+
+	return tokens, positions, nil
+}
+
+// splitRPN splits a postfix expression at whitespace and returns the words along with their byte offsets in expr.
+func splitRPN(expr string) ([]string, []int) {
+	var words []string
+	var positions []int
+
+	for i := 0; i < len(expr); {
+		c, width := utf8.DecodeRuneInString(expr[i:])
+		if unicode.IsSpace(c) {
+			i += width
+			continue
+		}
+
+		start := i
+		for i < len(expr) {
+			c, width := utf8.DecodeRuneInString(expr[i:])
+			if unicode.IsSpace(c) {
+				break
+			}
+			i += width
+		}
+
+		words = append(words, expr[start:i])
+		positions = append(positions, start)
+	}
+
+	return words, positions
+}
+
+func parseTokens(tokenStrs []string) ([]*v1pb.Token, error) {
+	tokens := make([]*v1pb.Token, len(tokenStrs))
+	for i, tokStr := range tokenStrs {
+		tok, err := parseToken(tokStr)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens[i] = tok
+	}
+
+	return tokens, nil
+}
+
+// stackOps maps the dc-style stack manipulation words to their tokens.
+var stackOps = map[string]v1pb.StackOp{
+	"dup":   v1pb.DUP,
+	"swap":  v1pb.SWAP,
+	"drop":  v1pb.DROP,
+	"rot":   v1pb.ROT,
+	"clear": v1pb.CLEAR,
+}
+
+func parseToken(tokenStr string) (*v1pb.Token, error) {
+	tokStr := strings.TrimSpace(tokenStr)
+	switch tokStr {
+	case "+":
+		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.ADD}}, nil
+	case "-":
+		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.SUBTRACT}}, nil
+	case "*":
+		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.MULTIPLY}}, nil
+	case "/":
+		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.DIVIDE}}, nil
+	case "^":
+		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.POW}}, nil
+	case "%":
+		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.MOD}}, nil
+	case "//":
+		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v1pb.IDIV}}, nil
+	default:
+		if op, ok := stackOps[strings.ToLower(tokStr)]; ok {
+			return &v1pb.Token{Token: &v1pb.Token_StackOp{StackOp: op}}, nil
+		}
+
+		if reg, ok := parseRegister(tokStr); ok {
+			return &v1pb.Token{Token: &v1pb.Token_Register{Register: reg}}, nil
+		}
+
+		if fn, ok := parseFunction(tokStr); ok {
+			return &v1pb.Token{Token: &v1pb.Token_Function{Function: fn}}, nil
+		}
+
+		// strconv also accepts "inf" and "nan", which are left to be variable names as numbers must be finite
+		v, err := strconv.ParseFloat(tokStr, 64)
+		if err == nil && (math.IsInf(v, 0) || math.IsNaN(v)) {
+			err = &strconv.NumError{Func: "ParseFloat", Num: tokStr, Err: strconv.ErrSyntax}
+		}
+
+		if err != nil {
+			if isIdent(tokStr) {
+				return &v1pb.Token{Token: &v1pb.Token_Variable{Variable: &v1pb.Variable{Name: tokStr}}}, nil
+			}
+			return nil, err
+		}
+		return &v1pb.Token{Token: &v1pb.Token_Operand{Operand: &v1pb.Operand{Value: v, Decimal: tokStr}}}, nil
+	}
+}
+
+// parseFunction recognizes function names such as "sqrt". The number of arguments for variadic functions is given
+// after a colon as in "max:3".
+func parseFunction(tokStr string) (*v1pb.Function, bool) {
+	name, arity := strings.ToLower(tokStr), 0
+	if i := strings.LastIndexByte(name, ':'); i > 0 {
+		n, err := strconv.Atoi(name[i+1:])
+		if err != nil {
+			return nil, false
+		}
+		name, arity = name[:i], n
+	}
+
+	if _, ok := functions[name]; !ok {
+		return nil, false
+	}
+
+	return &v1pb.Function{Name: name, Arity: int32(arity)}, true
+}
+
+// registerOps maps the register words to their operations.
+var registerOps = map[string]v1pb.RegisterOp{
+	"sto": v1pb.STO,
+	"rcl": v1pb.RCL,
+}
+
+// parseRegister recognizes register operations such as "sto:a" and "rcl:a". Register names consist of letters, digits
+// and underscores.
+func parseRegister(tokStr string) (*v1pb.Register, bool) {
+	i := strings.IndexByte(tokStr, ':')
+	if i < 0 {
+		return nil, false
+	}
+
+	op, ok := registerOps[strings.ToLower(tokStr[:i])]
+	name := tokStr[i+1:]
+	if !ok || name == "" || scanIdent(name) != len(name) {
+		return nil, false
+	}
+
+	return &v1pb.Register{Op: op, Name: name}, true
+}
+
+// operatorSymbols maps the operators to the symbols recognized by parseToken.
+var operatorSymbols = map[v1pb.Operator]string{
+	v1pb.ADD:      "+",
+	v1pb.SUBTRACT: "-",
+	v1pb.MULTIPLY: "*",
+	v1pb.DIVIDE:   "/",
+	v1pb.POW:      "^",
+	v1pb.MOD:      "%",
+	v1pb.IDIV:     "//",
+}
+
+// formatToken returns the textual form of a token as accepted by parseToken.
+func formatToken(tok *v1pb.Token) string {
+	switch v := tok.GetToken().(type) {
+	case *v1pb.Token_Operand:
+		if v.Operand.GetDecimal() != "" {
+			return v.Operand.GetDecimal()
+		}
+		return strconv.FormatFloat(v.Operand.GetValue(), 'f', -1, 64)
+	case *v1pb.Token_Operator:
+		if sym, ok := operatorSymbols[v.Operator]; ok {
+			return sym
+		}
+		return v.Operator.String()
+	case *v1pb.Token_Function:
+		if v.Function.GetArity() > 0 {
+			return fmt.Sprintf("%s:%d", v.Function.GetName(), v.Function.GetArity())
+		}
+		return v.Function.GetName()
+	case *v1pb.Token_StackOp:
+		return strings.ToLower(v.StackOp.String())
+	case *v1pb.Token_Variable:
+		return v.Variable.GetName()
+	case *v1pb.Token_Register:
+		return fmt.Sprintf("%s:%s", strings.ToLower(v.Register.GetOp().String()), v.Register.GetName())
+	default:
+		return ""
+	}
+}
+
+// isIdent reports whether s is a valid variable name: a letter or underscore followed by letters, digits or
+// underscores.
+func isIdent(s string) bool {
+	if c, _ := utf8.DecodeRuneInString(s); s == "" || unicode.IsDigit(c) {
+		return false
+	}
+
+	return scanIdent(s) == len(s)
+}
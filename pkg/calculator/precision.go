@@ -0,0 +1,589 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+)
+
+const (
+	// defaultFloatPrecision is the mantissa size in bits used by BIG_FLOAT when the request does not specify one
+	defaultFloatPrecision = 256
+	maxFloatPrecision     = 1 << 14
+	// maxRatBits limits the combined size of the numerator and denominator of exact values to avoid unbounded memory
+	// and CPU use
+	maxRatBits = 1 << 16
+	// nonTerminatingDecimalPlaces is the number of decimal places used to format exact results such as 1/3
+	nonTerminatingDecimalPlaces = 40
+)
+
+var (
+	errDivisionByZero        = newEvalError(v1pb.DIVISION_BY_ZERO, "division by zero")
+	errModuloByZero          = newEvalError(v1pb.DIVISION_BY_ZERO, "modulo by zero")
+	errIntegerDivisionByZero = newEvalError(v1pb.DIVISION_BY_ZERO, "integer division by zero")
+	errOutOfRange            = newEvalError(v1pb.OUT_OF_RANGE, "result out of range")
+	errNonFinite             = newEvalError(v1pb.INVALID_OPERAND, "infinite and NaN operands are not supported in this precision mode")
+)
+
+// value is a stack entry. Its concrete type is determined by the arithmetic in use: float64, *big.Float or *big.Rat.
+type value interface{}
+
+// arithmetic implements the operations of the evaluator for a particular numeric representation.
+type arithmetic interface {
+	operand(o *v1pb.Operand) (value, error)
+	fromFloat64(v float64) (value, error)
+	toFloat64(v value) float64
+	// format returns the decimal representation of v and, for exact arithmetic, the fraction in lowest terms.
+	format(v value) (decimal, fraction string)
+	binary(op v1pb.Operator, x, y value) (value, error)
+	function(name string, fn function, args []value) (value, error)
+}
+
+// newArithmetic returns the arithmetic for the precision mode requested by the client.
+// floatPrecision is the mantissa size in bits for BIG_FLOAT and is ignored by the other modes.
+func newArithmetic(precision v1pb.Precision, floatPrecision uint32) (arithmetic, error) {
+	switch precision {
+	case v1pb.FLOAT64:
+		return floatArithmetic{}, nil
+	case v1pb.BIG_FLOAT:
+		if floatPrecision == 0 {
+			floatPrecision = defaultFloatPrecision
+		}
+
+		if floatPrecision > maxFloatPrecision {
+			return nil, fmt.Errorf("float precision must not exceed %d bits", maxFloatPrecision)
+		}
+		return bigFloatArithmetic{prec: uint(floatPrecision)}, nil
+	case v1pb.EXACT:
+		return ratArithmetic{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported precision: %s", precision)
+	}
+}
+
+// floatArithmetic uses IEEE-754 double precision floats.
+type floatArithmetic struct{}
+
+func (floatArithmetic) operand(o *v1pb.Operand) (value, error) {
+	return o.GetValue(), nil
+}
+
+func (floatArithmetic) fromFloat64(v float64) (value, error) {
+	return v, nil
+}
+
+func (floatArithmetic) toFloat64(v value) float64 {
+	return v.(float64)
+}
+
+func (floatArithmetic) format(v value) (string, string) {
+	return strconv.FormatFloat(v.(float64), 'f', -1, 64), ""
+}
+
+func (floatArithmetic) binary(op v1pb.Operator, x, y value) (value, error) {
+	v1, v2 := x.(float64), y.(float64)
+	switch op {
+	case v1pb.ADD:
+		return v1 + v2, nil
+	case v1pb.SUBTRACT:
+		return v1 - v2, nil
+	case v1pb.MULTIPLY:
+		return v1 * v2, nil
+	case v1pb.DIVIDE:
+		return v1 / v2, nil
+	case v1pb.POW:
+		if v1 < 0 && v2 != math.Trunc(v2) {
+			return nil, newEvalError(v1pb.DOMAIN_ERROR, "negative base with fractional exponent")
+		}
+		return math.Pow(v1, v2), nil
+	case v1pb.MOD:
+		if v2 == 0 {
+			return nil, errModuloByZero
+		}
+		return floorMod(v1, v2), nil
+	case v1pb.IDIV:
+		if v2 == 0 {
+			return nil, errIntegerDivisionByZero
+		}
+		return math.Floor(v1 / v2), nil
+	default:
+		return nil, evalErrorf(v1pb.UNKNOWN_OPERATION, "unimplemented operator: %s", op)
+	}
+}
+
+func (floatArithmetic) function(name string, fn function, args []value) (value, error) {
+	fargs := make([]float64, len(args))
+	for i, a := range args {
+		fargs[i] = a.(float64)
+	}
+
+	result := fn.eval(fargs)
+	if math.IsNaN(result) {
+		return nil, evalErrorf(v1pb.DOMAIN_ERROR, "%s: argument out of domain", name)
+	}
+
+	return result, nil
+}
+
+// floorMod returns the remainder of the floored division of x by y so that x == y*floor(x/y) + floorMod(x, y).
+func floorMod(x, y float64) float64 {
+	m := math.Mod(x, y)
+	if m != 0 && (m < 0) != (y < 0) {
+		m += y
+	}
+
+	return m
+}
+
+// bigFloatArithmetic uses binary floating point numbers with a configurable mantissa size.
+// Functions without an arbitrary-precision implementation are computed with float64 precision.
+type bigFloatArithmetic struct {
+	prec uint
+}
+
+func (a bigFloatArithmetic) newFloat() *big.Float {
+	return new(big.Float).SetPrec(a.prec)
+}
+
+func (a bigFloatArithmetic) operand(o *v1pb.Operand) (value, error) {
+	if o.GetDecimal() == "" {
+		return a.fromFloat64(o.GetValue())
+	}
+
+	f, _, err := big.ParseFloat(o.GetDecimal(), 10, a.prec, big.ToNearestEven)
+	if err != nil {
+		return nil, evalErrorf(v1pb.INVALID_OPERAND, "invalid decimal operand %q", o.GetDecimal())
+	}
+
+	if f.IsInf() {
+		return nil, errNonFinite
+	}
+
+	return f, nil
+}
+
+func (a bigFloatArithmetic) fromFloat64(v float64) (value, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, errNonFinite
+	}
+
+	return a.newFloat().SetFloat64(v), nil
+}
+
+func (bigFloatArithmetic) toFloat64(v value) float64 {
+	f, _ := v.(*big.Float).Float64()
+	return f
+}
+
+func (bigFloatArithmetic) format(v value) (string, string) {
+	return v.(*big.Float).Text('f', -1), ""
+}
+
+func (a bigFloatArithmetic) binary(op v1pb.Operator, x, y value) (value, error) {
+	v1, v2 := x.(*big.Float), y.(*big.Float)
+	z := a.newFloat()
+
+	switch op {
+	case v1pb.ADD:
+		z.Add(v1, v2)
+	case v1pb.SUBTRACT:
+		z.Sub(v1, v2)
+	case v1pb.MULTIPLY:
+		z.Mul(v1, v2)
+	case v1pb.DIVIDE:
+		if v2.Sign() == 0 {
+			return nil, errDivisionByZero
+		}
+		z.Quo(v1, v2)
+	case v1pb.POW:
+		if !v2.IsInt() {
+			// fractional exponents are only supported with float64 precision
+			return a.viaFloat64(func() (value, error) {
+				return floatArithmetic{}.binary(op, a.toFloat64(v1), a.toFloat64(v2))
+			})
+		}
+
+		n, acc := v2.Int64()
+		if acc != big.Exact {
+			return nil, errOutOfRange
+		}
+
+		if v1.Sign() == 0 && n < 0 {
+			return nil, errDivisionByZero
+		}
+		z = a.pow(v1, n)
+	case v1pb.MOD, v1pb.IDIV:
+		if v2.Sign() == 0 {
+			return nil, divisionByZero(op)
+		}
+
+		q := a.newFloat().Quo(v1, v2)
+		if q.IsInf() {
+			return nil, errOutOfRange
+		}
+
+		z = a.floor(q)
+		if op == v1pb.MOD {
+			z.Sub(v1, a.newFloat().Mul(v2, z))
+		}
+	default:
+		return nil, evalErrorf(v1pb.UNKNOWN_OPERATION, "unimplemented operator: %s", op)
+	}
+
+	if z.IsInf() {
+		return nil, errOutOfRange
+	}
+
+	return z, nil
+}
+
+// pow computes x^n by repeated squaring.
+func (a bigFloatArithmetic) pow(x *big.Float, n int64) *big.Float {
+	neg := n < 0
+	if neg {
+		n = -n
+	}
+
+	result := a.newFloat().SetInt64(1)
+	base := a.newFloat().Set(x)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result.Mul(result, base)
+		}
+		base.Mul(base, base)
+	}
+
+	if neg {
+		return a.newFloat().Quo(a.newFloat().SetInt64(1), result)
+	}
+
+	return result
+}
+
+// floor rounds x towards negative infinity.
+func (a bigFloatArithmetic) floor(x *big.Float) *big.Float {
+	if x.IsInt() {
+		return a.newFloat().Set(x)
+	}
+
+	// the integer part of a number with a fractional part is smaller than 2^prec
+	i, acc := x.Int(nil)
+	if acc == big.Above {
+		i.Sub(i, big.NewInt(1))
+	}
+
+	return a.newFloat().SetInt(i)
+}
+
+func (a bigFloatArithmetic) function(name string, fn function, args []value) (value, error) {
+	fargs := make([]*big.Float, len(args))
+	for i, arg := range args {
+		fargs[i] = arg.(*big.Float)
+	}
+
+	switch name {
+	case "sqrt":
+		if fargs[0].Sign() < 0 {
+			return nil, evalErrorf(v1pb.DOMAIN_ERROR, "%s: argument out of domain", name)
+		}
+		return a.newFloat().Sqrt(fargs[0]), nil
+	case "neg":
+		return a.newFloat().Neg(fargs[0]), nil
+	case "abs":
+		return a.newFloat().Abs(fargs[0]), nil
+	case "floor", "ceil", "trunc", "round":
+		if fargs[0].IsInt() {
+			return a.newFloat().Set(fargs[0]), nil
+		}
+
+		// numbers with a fractional part are small enough to be converted to rationals cheaply
+		r, _ := fargs[0].Rat(nil)
+		r, _ = exactFunction(name, []*big.Rat{r})
+		return a.newFloat().SetRat(r), nil
+	case "min", "max":
+		m := fargs[0]
+		for _, v := range fargs[1:] {
+			if c := v.Cmp(m); (name == "min" && c < 0) || (name == "max" && c > 0) {
+				m = v
+			}
+		}
+		return a.newFloat().Set(m), nil
+	case "sum", "avg":
+		s := a.newFloat()
+		for _, v := range fargs {
+			s.Add(s, v)
+		}
+
+		if name == "avg" {
+			s.Quo(s, a.newFloat().SetInt64(int64(len(fargs))))
+		}
+
+		if s.IsInf() {
+			return nil, errOutOfRange
+		}
+		return s, nil
+	}
+
+	return a.viaFloat64(func() (value, error) {
+		fargs := make([]value, len(args))
+		for i, arg := range args {
+			fargs[i] = a.toFloat64(arg)
+		}
+		return floatArithmetic{}.function(name, fn, fargs)
+	})
+}
+
+// viaFloat64 converts the float64 result of f to the configured precision.
+func (a bigFloatArithmetic) viaFloat64(f func() (value, error)) (value, error) {
+	v, err := f()
+	if err != nil {
+		return nil, err
+	}
+
+	if math.IsInf(v.(float64), 0) {
+		return nil, errOutOfRange
+	}
+
+	return a.fromFloat64(v.(float64))
+}
+
+// ratArithmetic uses exact rational numbers. Operations that produce irrational results are not supported.
+type ratArithmetic struct{}
+
+func (a ratArithmetic) operand(o *v1pb.Operand) (value, error) {
+	if o.GetDecimal() == "" {
+		return a.fromFloat64(o.GetValue())
+	}
+
+	r, ok := new(big.Rat).SetString(o.GetDecimal())
+	if !ok {
+		return nil, evalErrorf(v1pb.INVALID_OPERAND, "invalid decimal operand %q", o.GetDecimal())
+	}
+
+	return checkRatSize(r)
+}
+
+func (ratArithmetic) fromFloat64(v float64) (value, error) {
+	r := new(big.Rat).SetFloat64(v)
+	if r == nil {
+		return nil, errNonFinite
+	}
+
+	return r, nil
+}
+
+func (ratArithmetic) toFloat64(v value) float64 {
+	f, _ := v.(*big.Rat).Float64()
+	return f
+}
+
+func (ratArithmetic) format(v value) (string, string) {
+	r := v.(*big.Rat)
+	if places, ok := decimalPlaces(r.Denom()); ok {
+		return r.FloatString(places), r.RatString()
+	}
+
+	return r.FloatString(nonTerminatingDecimalPlaces), r.RatString()
+}
+
+// decimalPlaces returns the number of decimal places needed to represent a fraction with the given denominator
+// exactly. This is only possible when the denominator has no prime factors other than 2 and 5.
+func decimalPlaces(denom *big.Int) (int, bool) {
+	d := new(big.Int).Set(denom)
+	places := 0
+	for _, p := range []int64{2, 5} {
+		n := 0
+		prime := big.NewInt(p)
+		m := new(big.Int)
+		for {
+			q, rem := new(big.Int).QuoRem(d, prime, m)
+			if rem.Sign() != 0 {
+				break
+			}
+			d = q
+			n++
+		}
+
+		if n > places {
+			places = n
+		}
+	}
+
+	return places, d.Cmp(big.NewInt(1)) == 0
+}
+
+func (a ratArithmetic) binary(op v1pb.Operator, x, y value) (value, error) {
+	r, err := a.exactBinary(op, x.(*big.Rat), y.(*big.Rat))
+	if err != nil {
+		return nil, err
+	}
+
+	return checkRatSize(r)
+}
+
+func (ratArithmetic) exactBinary(op v1pb.Operator, v1, v2 *big.Rat) (*big.Rat, error) {
+	z := new(big.Rat)
+
+	switch op {
+	case v1pb.ADD:
+		return z.Add(v1, v2), nil
+	case v1pb.SUBTRACT:
+		return z.Sub(v1, v2), nil
+	case v1pb.MULTIPLY:
+		return z.Mul(v1, v2), nil
+	case v1pb.DIVIDE:
+		if v2.Sign() == 0 {
+			return nil, errDivisionByZero
+		}
+		return z.Quo(v1, v2), nil
+	case v1pb.POW:
+		if !v2.IsInt() {
+			return nil, newEvalError(v1pb.UNSUPPORTED_OPERATION, "fractional exponents are not supported in exact mode")
+		}
+
+		// the result has about |n| times as many bits as the base, which is compared by division to avoid overflows
+		if !v2.Num().IsInt64() || v2.Num().Int64() == math.MinInt64 {
+			return nil, errOutOfRange
+		}
+
+		n := v2.Num().Int64()
+		e := n
+		if e < 0 {
+			e = -e
+		}
+
+		if e > maxRatBits/int64(ratBits(v1)) {
+			return nil, errOutOfRange
+		}
+
+		if v1.Sign() == 0 && n < 0 {
+			return nil, errDivisionByZero
+		}
+		return ratPow(v1, n), nil
+	case v1pb.MOD, v1pb.IDIV:
+		if v2.Sign() == 0 {
+			return nil, divisionByZero(op)
+		}
+
+		q := z.SetInt(ratFloor(new(big.Rat).Quo(v1, v2)))
+		if op == v1pb.MOD {
+			return new(big.Rat).Sub(v1, q.Mul(q, v2)), nil
+		}
+		return q, nil
+	default:
+		return nil, evalErrorf(v1pb.UNKNOWN_OPERATION, "unimplemented operator: %s", op)
+	}
+}
+
+func (ratArithmetic) function(name string, _ function, args []value) (value, error) {
+	rargs := make([]*big.Rat, len(args))
+	for i, arg := range args {
+		rargs[i] = arg.(*big.Rat)
+	}
+
+	if r, ok := exactFunction(name, rargs); ok {
+		return checkRatSize(r)
+	}
+
+	return nil, evalErrorf(v1pb.UNSUPPORTED_OPERATION, "%s is not supported in exact mode", name)
+}
+
+// exactFunction evaluates the functions whose results are always rational.
+func exactFunction(name string, args []*big.Rat) (*big.Rat, bool) {
+	switch name {
+	case "neg":
+		return new(big.Rat).Neg(args[0]), true
+	case "abs":
+		return new(big.Rat).Abs(args[0]), true
+	case "floor":
+		return new(big.Rat).SetInt(ratFloor(args[0])), true
+	case "ceil":
+		return new(big.Rat).SetInt(ratCeil(args[0])), true
+	case "trunc":
+		return new(big.Rat).SetInt(new(big.Int).Quo(args[0].Num(), args[0].Denom())), true
+	case "round":
+		// half away from zero, as math.Round
+		half := new(big.Rat).Add(new(big.Rat).Abs(args[0]), big.NewRat(1, 2))
+		r := new(big.Rat).SetInt(ratFloor(half))
+		if args[0].Sign() < 0 {
+			r.Neg(r)
+		}
+		return r, true
+	case "min", "max":
+		m := args[0]
+		for _, v := range args[1:] {
+			if c := v.Cmp(m); (name == "min" && c < 0) || (name == "max" && c > 0) {
+				m = v
+			}
+		}
+		return new(big.Rat).Set(m), true
+	case "sum", "avg":
+		s := new(big.Rat)
+		for _, v := range args {
+			// the denominator can grow with every term so stop early if the sum is going to be rejected anyway
+			if ratBits(s) > maxRatBits {
+				break
+			}
+			s.Add(s, v)
+		}
+
+		if name == "avg" {
+			s.Quo(s, new(big.Rat).SetInt64(int64(len(args))))
+		}
+		return s, true
+	default:
+		return nil, false
+	}
+}
+
+func divisionByZero(op v1pb.Operator) error {
+	switch op {
+	case v1pb.MOD:
+		return errModuloByZero
+	case v1pb.IDIV:
+		return errIntegerDivisionByZero
+	default:
+		return errDivisionByZero
+	}
+}
+
+func ratFloor(r *big.Rat) *big.Int {
+	// the denominator is always positive so Euclidean division rounds towards negative infinity
+	return new(big.Int).Div(r.Num(), r.Denom())
+}
+
+func ratCeil(r *big.Rat) *big.Int {
+	f := ratFloor(r)
+	if !r.IsInt() {
+		f.Add(f, big.NewInt(1))
+	}
+	return f
+}
+
+func ratPow(x *big.Rat, n int64) *big.Rat {
+	e := new(big.Int).Abs(big.NewInt(n))
+	num := new(big.Int).Exp(x.Num(), e, nil)
+	denom := new(big.Int).Exp(x.Denom(), e, nil)
+	if n < 0 {
+		num, denom = denom, num
+	}
+
+	return new(big.Rat).SetFrac(num, denom)
+}
+
+// ratBits returns the combined size of the numerator and denominator of r, which is at least 1.
+func ratBits(r *big.Rat) int {
+	return r.Num().BitLen() + r.Denom().BitLen()
+}
+
+// checkRatSize rejects exact values that are too large to compute with.
+func checkRatSize(r *big.Rat) (value, error) {
+	if ratBits(r) > maxRatBits {
+		return nil, errOutOfRange
+	}
+
+	return r, nil
+}
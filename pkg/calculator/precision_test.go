@@ -0,0 +1,238 @@
+package calculator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecision(t *testing.T) {
+	testCases := []struct {
+		name           string
+		precision      v1pb.Precision
+		floatPrecision uint32
+		tokens         []string
+		wantDecimal    string
+		wantFraction   string
+		wantErr        bool
+	}{
+		{
+			name:        "float64Rounding",
+			precision:   v1pb.FLOAT64,
+			tokens:      []string{"0.1", "0.2", "+"},
+			wantDecimal: "0.30000000000000004",
+		},
+		{
+			name:         "exactDecimal",
+			precision:    v1pb.EXACT,
+			tokens:       []string{"0.1", "0.2", "+"},
+			wantDecimal:  "0.3",
+			wantFraction: "3/10",
+		},
+		{
+			name:         "exactNonTerminating",
+			precision:    v1pb.EXACT,
+			tokens:       []string{"1", "3", "/"},
+			wantDecimal:  "0." + strings.Repeat("3", nonTerminatingDecimalPlaces),
+			wantFraction: "1/3",
+		},
+		{
+			name:         "exactLargeIntegers",
+			precision:    v1pb.EXACT,
+			tokens:       []string{"123456789012345678901234567890", "1", "+"},
+			wantDecimal:  "123456789012345678901234567891",
+			wantFraction: "123456789012345678901234567891",
+		},
+		{
+			name:         "exactPow",
+			precision:    v1pb.EXACT,
+			tokens:       []string{"2", "100", "^"},
+			wantDecimal:  "1267650600228229401496703205376",
+			wantFraction: "1267650600228229401496703205376",
+		},
+		{
+			name:         "exactNegativePow",
+			precision:    v1pb.EXACT,
+			tokens:       []string{"-2", "-3", "^"},
+			wantDecimal:  "-0.125",
+			wantFraction: "-1/8",
+		},
+		{
+			name:      "exactFractionalPow",
+			precision: v1pb.EXACT,
+			tokens:    []string{"2", "0.5", "^"},
+			wantErr:   true,
+		},
+		{
+			name:      "exactHugePow",
+			precision: v1pb.EXACT,
+			tokens:    []string{"3", "1000000", "^"},
+			wantErr:   true,
+		},
+		{
+			name:         "exactFloorDivision",
+			precision:    v1pb.EXACT,
+			tokens:       []string{"-7", "2", "//", "-7", "3", "%", "+"},
+			wantDecimal:  "-2",
+			wantFraction: "-2",
+		},
+		{
+			name:         "exactFractionalModulo",
+			precision:    v1pb.EXACT,
+			tokens:       []string{"5.5", "-2", "%"},
+			wantDecimal:  "-0.5",
+			wantFraction: "-1/2",
+		},
+		{
+			name:      "exactDivisionByZero",
+			precision: v1pb.EXACT,
+			tokens:    []string{"1", "0", "/"},
+			wantErr:   true,
+		},
+		{
+			name:         "exactFunctions",
+			precision:    v1pb.EXACT,
+			tokens:       []string{"-2.5", "round", "1", "2", "4", "avg:3", "+"},
+			wantDecimal:  "-0.6666666666666666666666666666666666666667",
+			wantFraction: "-2/3",
+		},
+		{
+			name:      "exactIrrationalFunction",
+			precision: v1pb.EXACT,
+			tokens:    []string{"2", "sqrt"},
+			wantErr:   true,
+		},
+		{
+			name:        "bigFloatSqrt",
+			precision:   v1pb.BIG_FLOAT,
+			tokens:      []string{"2", "sqrt"},
+			wantDecimal: "1.4142135623730950488016887242096980785696718753769480731766797379907324784621",
+		},
+		{
+			name:           "bigFloatPrecision",
+			precision:      v1pb.BIG_FLOAT,
+			floatPrecision: 24,
+			tokens:         []string{"1", "3", "/"},
+			wantDecimal:    "0.33333334",
+		},
+		{
+			name:        "bigFloatFloorDivision",
+			precision:   v1pb.BIG_FLOAT,
+			tokens:      []string{"-7", "2", "//", "7.5", "2", "%", "+"},
+			wantDecimal: "-2.5",
+		},
+		{
+			name:        "bigFloatFallback",
+			precision:   v1pb.BIG_FLOAT,
+			tokens:      []string{"0", "cos"},
+			wantDecimal: "1",
+		},
+		{
+			name:      "bigFloatModuloOverflow",
+			precision: v1pb.BIG_FLOAT,
+			tokens:    []string{"10", "600000000", "^", "10", "-600000000", "^", "%"},
+			wantErr:   true,
+		},
+		{
+			name:      "bigFloatIntegerDivisionOverflow",
+			precision: v1pb.BIG_FLOAT,
+			tokens:    []string{"10", "600000000", "^", "10", "-600000000", "^", "//"},
+			wantErr:   true,
+		},
+		{
+			name:        "bigFloatLargeExponentFunctions",
+			precision:   v1pb.BIG_FLOAT,
+			tokens:      []string{"10", "600000000", "^", "dup", "floor", "dup", "abs", "max:2", "-"},
+			wantDecimal: "0",
+		},
+		{
+			name:        "bigFloatFunctions",
+			precision:   v1pb.BIG_FLOAT,
+			tokens:      []string{"-2.5", "round", "-2.5", "ceil", "-2.5", "trunc", "1", "2", "4", "avg:3", "sum:4"},
+			wantDecimal: "-4.6666666666666666666666666666666666666666666666666666666666666666666666666667",
+		},
+		{
+			name:      "bigFloatDivisionByZero",
+			precision: v1pb.BIG_FLOAT,
+			tokens:    []string{"1", "0", "/"},
+			wantErr:   true,
+		},
+		{
+			name:           "bigFloatPrecisionTooHigh",
+			precision:      v1pb.BIG_FLOAT,
+			floatPrecision: maxFloatPrecision + 1,
+			tokens:         []string{"1"},
+			wantErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			haveDecimal, haveFraction, err := evaluateWithPrecision(tc.precision, tc.floatPrecision, tc.tokens)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantDecimal, haveDecimal)
+			require.Equal(t, tc.wantFraction, haveFraction)
+		})
+	}
+}
+
+func evaluateWithPrecision(precision v1pb.Precision, floatPrecision uint32, tokenStrs []string) (string, string, error) {
+	arith, err := newArithmetic(precision, floatPrecision)
+	if err != nil {
+		return "", "", err
+	}
+
+	tokens, err := parseTokens(tokenStrs)
+	if err != nil {
+		return "", "", err
+	}
+
+	result, err := evaluate(&rpnEvaluator{arith: arith}, tokens)
+	if err != nil {
+		return "", "", err
+	}
+
+	decimal, fraction := arith.format(result)
+	return decimal, fraction, nil
+}
+
+func TestExactSizeLimit(t *testing.T) {
+	repeatedSquaring := []string{"3"}
+	for i := 0; i < 26; i++ {
+		repeatedSquaring = append(repeatedSquaring, "dup", "*")
+	}
+
+	testCases := []struct {
+		name   string
+		tokens []string
+	}{
+		{name: "repeatedSquaring", tokens: repeatedSquaring},
+		{name: "powOverflowingGuard", tokens: []string{"3", "4000000000000000000", "^"}},
+		{name: "powMinInt64", tokens: []string{"3", "-9223372036854775808", "^"}},
+		{name: "powJustTooLarge", tokens: []string{"2", "65536", "^"}},
+		{name: "sumOfLargeFractions", tokens: []string{"1", "10", "19000", "^", "1", "+", "/", "1", "10", "19000", "^", "3", "+", "/", "sum:2"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := evaluateWithPrecision(v1pb.EXACT, 0, tc.tokens)
+			require.Error(t, err)
+
+			eerr, ok := evaluationError(err, nil).(*EvaluationError)
+			require.True(t, ok, "expected *EvaluationError, got %T", err)
+			require.Equal(t, v1pb.OUT_OF_RANGE, eerr.Reason)
+		})
+	}
+
+	t.Run("hugeDecimalOperand", func(t *testing.T) {
+		_, err := ratArithmetic{}.operand(&v1pb.Operand{Decimal: "1e100000"})
+		require.Equal(t, errOutOfRange, err)
+	})
+}
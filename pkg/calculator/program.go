@@ -0,0 +1,211 @@
+package calculator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// program is a token sequence that has been checked once so that it can be evaluated repeatedly.
+type program struct {
+	tokens []*v1pb.Token
+	// slots holds the position of the value of each variable token in variables, or -1 for other tokens
+	slots     []int
+	variables []string
+	// maxDepth is the deepest the stack gets while running the program
+	maxDepth int
+}
+
+// compile checks that every token is valid and that the stack is balanced without evaluating the expression, and
+// assigns a slot to each distinct variable. maxDepth is the stack limit to check against and can be zero for no limit.
+// Problems are reported as a gRPC status error.
+func compile(tokens []*v1pb.Token, maxDepth int) (*program, error) {
+	reached, problems := analyze(tokens, maxDepth)
+	if len(problems) > 0 {
+		p := problems[0]
+		var tok *v1pb.Token
+		if p.index >= 0 {
+			tok = tokens[p.index]
+		}
+		return nil, evaluationStatus(p.err, p.index, tok, p.depth, maxDepth)
+	}
+
+	prog := &program{tokens: tokens, slots: make([]int, len(tokens)), maxDepth: reached}
+	slots := make(map[string]int)
+	for i, t := range tokens {
+		prog.slots[i] = -1
+		if v := t.GetVariable(); v != nil {
+			slot, ok := slots[v.GetName()]
+			if !ok {
+				slot = len(prog.variables)
+				slots[v.GetName()] = slot
+				prog.variables = append(prog.variables, v.GetName())
+			}
+			prog.slots[i] = slot
+		}
+	}
+
+	return prog, nil
+}
+
+// stackProblem is an issue found by analyze.
+type stackProblem struct {
+	// index of the offending token or -1 if the problem is not caused by a particular token
+	index int
+	// depth of the stack before the offending token
+	depth int
+	err   error
+}
+
+// analyze simulates the effect of the tokens on the stack depth and returns the maximum depth reached along with all
+// of the problems found, in token order. Analysis carries on after a problem by skipping invalid tokens and assuming
+// that missing operands were present. Overflowing maxDepth is reported once and a zero maxDepth means no limit.
+func analyze(tokens []*v1pb.Token, maxDepth int) (int, []stackProblem) {
+	var problems []stackProblem
+	depth, reached := 0, 0
+	overflowed := false
+
+	for i, t := range tokens {
+		needs, delta, err := stackEffect(t)
+		if err != nil {
+			problems = append(problems, stackProblem{index: i, depth: depth, err: err})
+			continue
+		}
+
+		if depth < needs {
+			problems = append(problems, stackProblem{index: i, depth: depth, err: errNotEnoughOperands})
+			depth = needs
+		}
+
+		if t.GetStackOp() == v1pb.CLEAR {
+			depth = 0
+		} else {
+			depth += delta
+		}
+
+		if maxDepth > 0 && depth > maxDepth && !overflowed {
+			problems = append(problems, stackProblem{index: i, depth: depth - delta, err: errStackFull})
+			overflowed = true
+		}
+
+		if depth > reached {
+			reached = depth
+		}
+	}
+
+	if depth != 1 {
+		problems = append(problems, stackProblem{index: -1, depth: depth, err: errIncomplete})
+	}
+
+	return reached, problems
+}
+
+// stackEffect returns the number of values the token needs in the stack and the change in stack depth after applying
+// it. CLEAR empties the stack regardless of the change reported.
+func stackEffect(t *v1pb.Token) (int, int, error) {
+	switch v := t.GetToken().(type) {
+	case *v1pb.Token_Operand, *v1pb.Token_Variable:
+		return 0, 1, nil
+	case *v1pb.Token_Operator:
+		if _, ok := operatorSymbols[v.Operator]; !ok {
+			return 0, 0, evalErrorf(v1pb.UNKNOWN_OPERATION, "unimplemented operator: %s", v.Operator)
+		}
+		return 2, -1, nil
+	case *v1pb.Token_Function:
+		_, arity, err := lookupFunction(strings.ToLower(v.Function.GetName()), int(v.Function.GetArity()))
+		if err != nil {
+			return 0, 0, err
+		}
+		return arity, 1 - arity, nil
+	case *v1pb.Token_StackOp:
+		switch v.StackOp {
+		case v1pb.DUP:
+			return 1, 1, nil
+		case v1pb.SWAP:
+			return 2, 0, nil
+		case v1pb.DROP:
+			return 1, -1, nil
+		case v1pb.ROT:
+			return 3, 0, nil
+		case v1pb.CLEAR:
+			return 0, 0, nil
+		default:
+			return 0, 0, evalErrorf(v1pb.UNKNOWN_OPERATION, "unimplemented stack operation: %s", v.StackOp)
+		}
+	case *v1pb.Token_Register:
+		switch v.Register.GetOp() {
+		case v1pb.STO:
+			return 1, 0, nil
+		case v1pb.RCL:
+			return 0, 1, nil
+		default:
+			return 0, 0, evalErrorf(v1pb.UNKNOWN_OPERATION, "unimplemented register operation: %s", v.Register.GetOp())
+		}
+	default:
+		return 0, 0, newEvalError(v1pb.UNKNOWN_OPERATION, "empty token")
+	}
+}
+
+// bind returns the column of values for each variable slot and the number of rows. All columns must have the same
+// number of rows.
+func (p *program) bind(columns map[string]*v1pb.Column) ([][]float64, int, error) {
+	bound := func(name string) bool {
+		_, ok := columns[name]
+		return ok
+	}
+
+	if names, first := unboundVariables(p.tokens, bound); len(names) > 0 {
+		err := evalErrorf(v1pb.UNBOUND_VARIABLE, "unbound variables: %s", strings.Join(names, ", "))
+		return nil, 0, evaluationStatus(err, first, p.tokens[first], 0, 0)
+	}
+
+	names := make([]string, 0, len(columns))
+	for name := range columns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rows := 0
+	for i, name := range names {
+		n := len(columns[name].GetValues())
+		if i == 0 {
+			rows = n
+		} else if n != rows {
+			msg := fmt.Sprintf("column %q has %d rows but column %q has %d", name, n, names[0], rows)
+			return nil, 0, status.Error(codes.InvalidArgument, msg)
+		}
+	}
+
+	values := make([][]float64, len(p.variables))
+	for slot, name := range p.variables {
+		values[slot] = columns[name].GetValues()
+	}
+
+	return values, rows, nil
+}
+
+// run evaluates a single row of the columns with float64 arithmetic. On failure, it returns the index of the failing
+// token or -1 if the failure is not associated with a token.
+func (p *program) run(rpn *rpnEvaluator, columns [][]float64, row int) (float64, int, error) {
+	rpn.reset()
+
+	for i, t := range p.tokens {
+		var err error
+		if slot := p.slots[i]; slot >= 0 {
+			err = rpn.pushOperand(columns[slot][row])
+		} else {
+			err = applyToken(rpn, t)
+		}
+
+		if err != nil {
+			return 0, i, err
+		}
+	}
+
+	result, err := rpn.result()
+	return result, -1, err
+}
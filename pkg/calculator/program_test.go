@@ -0,0 +1,104 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCompile(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tokens     []string
+		maxDepth   int
+		wantDepth  int
+		wantCode   codes.Code
+		wantReason v1pb.ErrorReason
+		wantIndex  int32
+	}{
+		{name: "simple", tokens: []string{"5", "8", "+", "3", "*"}, wantDepth: 2},
+		{name: "deep", tokens: []string{"1", "2", "3", "4", "+", "+", "+"}, wantDepth: 4},
+		{name: "variadic", tokens: []string{"1", "2", "3", "max:3", "sqrt"}, wantDepth: 3},
+		{name: "stackOps", tokens: []string{"2", "dup", "*", "3", "swap", "drop"}, wantDepth: 2},
+		{name: "clear", tokens: []string{"1", "2", "clear", "3"}, wantDepth: 2},
+		{name: "variables", tokens: []string{"x", "y", "+"}, wantDepth: 2},
+		{
+			name:       "underflow",
+			tokens:     []string{"1", "+"},
+			wantCode:   codes.InvalidArgument,
+			wantReason: v1pb.NOT_ENOUGH_OPERANDS,
+			wantIndex:  1,
+		},
+		{
+			name:       "functionUnderflow",
+			tokens:     []string{"1", "2", "sum:3"},
+			wantCode:   codes.InvalidArgument,
+			wantReason: v1pb.NOT_ENOUGH_OPERANDS,
+			wantIndex:  2,
+		},
+		{
+			name:       "unusedOperands",
+			tokens:     []string{"1", "2"},
+			wantCode:   codes.InvalidArgument,
+			wantReason: v1pb.INCOMPLETE_EXPRESSION,
+			wantIndex:  -1,
+		},
+		{
+			name:       "wrongArity",
+			tokens:     []string{"1", "2", "sqrt:2"},
+			wantCode:   codes.InvalidArgument,
+			wantReason: v1pb.WRONG_ARITY,
+			wantIndex:  2,
+		},
+		{
+			name:       "missingArity",
+			tokens:     []string{"1", "min"},
+			wantCode:   codes.InvalidArgument,
+			wantReason: v1pb.WRONG_ARITY,
+			wantIndex:  1,
+		},
+		{
+			name:       "stackFull",
+			tokens:     []string{"1", "2", "3", "+", "+"},
+			maxDepth:   2,
+			wantCode:   codes.ResourceExhausted,
+			wantReason: v1pb.STACK_FULL,
+			wantIndex:  2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := parseTokens(tc.tokens)
+			require.NoError(t, err)
+
+			prog, err := compile(tokens, tc.maxDepth)
+			if tc.wantCode == codes.OK {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantDepth, prog.maxDepth)
+				return
+			}
+
+			st := status.Convert(err)
+			require.Equal(t, tc.wantCode, st.Code())
+
+			var detail *v1pb.EvaluationError
+			for _, d := range st.Details() {
+				if e, ok := d.(*v1pb.EvaluationError); ok {
+					detail = e
+				}
+			}
+			require.NotNil(t, detail)
+			require.Equal(t, tc.wantReason, detail.Reason)
+			require.Equal(t, tc.wantIndex, detail.TokenIndex)
+		})
+	}
+}
+
+func TestCompileEmptyToken(t *testing.T) {
+	_, err := compile([]*v1pb.Token{{}}, 0)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
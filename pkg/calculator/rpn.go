@@ -1,64 +1,286 @@
 package calculator
 
 import (
-	"errors"
-	"fmt"
+	"strings"
 
 	"github.com/charithe/calculator/pkg/v1pb"
 )
 
-const stackSize = 16
+// maxTraceSteps is the maximum number of steps recorded in a trace.
+const maxTraceSteps = 10000
+
+var (
+	errStackFull         = newEvalError(v1pb.STACK_FULL, "stack full")
+	errNotEnoughOperands = newEvalError(v1pb.NOT_ENOUGH_OPERANDS, "not enough operands")
+	errIncomplete        = newEvalError(v1pb.INCOMPLETE_EXPRESSION, "incomplete expression: unused operands still in stack")
+)
 
 // rpnEvaluator implements a RPN expression evaluator.
+// The zero value evaluates using float64 arithmetic, has no limit on the stack depth and no variable bindings.
 // This is not thread-safe and should only be accessed by a single goroutine.
 type rpnEvaluator struct {
-	stack [stackSize]float64
-	ptr   int
+	arith    arithmetic
+	maxDepth int
+	bindings map[string]*v1pb.Operand
+	// trace records the state of the stack after each token when non-nil
+	trace *v1pb.Trace
+	// registers holds the values stored by STO. It is created on first use.
+	registers map[string]value
+	stack     []value
+	ptr       int
+}
+
+func (r *rpnEvaluator) arithmetic() arithmetic {
+	if r.arith == nil {
+		return floatArithmetic{}
+	}
+
+	return r.arith
 }
 
 func (r *rpnEvaluator) pushOperand(v float64) error {
-	if r.ptr >= stackSize-1 {
-		return errors.New("stack full")
+	val, err := r.arithmetic().fromFloat64(v)
+	if err != nil {
+		return err
+	}
+
+	return r.push(val)
+}
+
+// pushOperandToken pushes an operand token, using its decimal representation if the arithmetic supports it.
+func (r *rpnEvaluator) pushOperandToken(o *v1pb.Operand) error {
+	val, err := r.arithmetic().operand(o)
+	if err != nil {
+		return err
 	}
 
-	r.stack[r.ptr] = v
+	return r.push(val)
+}
+
+// pushVariable pushes the value bound to the named variable.
+func (r *rpnEvaluator) pushVariable(name string) error {
+	o, ok := r.bindings[name]
+	if !ok {
+		return evalErrorf(v1pb.UNBOUND_VARIABLE, "unbound variable: %s", name)
+	}
+
+	return r.pushOperandToken(o)
+}
+
+func (r *rpnEvaluator) push(v value) error {
+	if r.maxDepth > 0 && r.ptr >= r.maxDepth {
+		return errStackFull
+	}
+
+	r.stack = append(r.stack[:r.ptr], v)
 	r.ptr++
 	return nil
 }
 
-func (r *rpnEvaluator) pop() float64 {
+func (r *rpnEvaluator) pop() value {
 	r.ptr--
 	return r.stack[r.ptr]
 }
 
 func (r *rpnEvaluator) pushOperator(op v1pb.Operator) error {
 	if r.ptr < 2 {
-		return errors.New("not enough operands")
+		return errNotEnoughOperands
 	}
 
-	v2 := r.pop()
-	v1 := r.pop()
-	var result float64
+	// operands are only removed once the operator is known to be valid so that the stack is left intact on error
+	result, err := r.arithmetic().binary(op, r.stack[r.ptr-2], r.stack[r.ptr-1])
+	if err != nil {
+		return err
+	}
 
+	r.ptr -= 2
+	return r.push(result)
+}
+
+// pushFunction applies the named function to the operands at the top of the stack.
+// The arity is only required for variadic functions and is otherwise validated against the function definition when
+// non-zero.
+func (r *rpnEvaluator) pushFunction(name string, arity int) error {
+	name = strings.ToLower(name)
+	fn, arity, err := lookupFunction(name, arity)
+	if err != nil {
+		return err
+	}
+
+	if r.ptr < arity {
+		return errNotEnoughOperands
+	}
+
+	result, err := r.arithmetic().function(name, fn, r.stack[r.ptr-arity:r.ptr])
+	if err != nil {
+		return err
+	}
+
+	r.ptr -= arity
+	return r.push(result)
+}
+
+// lookupFunction returns the named function and the number of operands it consumes given the arity of the token.
+func lookupFunction(name string, arity int) (function, int, error) {
+	fn, ok := functions[name]
+	if !ok {
+		return function{}, 0, evalErrorf(v1pb.UNKNOWN_OPERATION, "unknown function: %s", name)
+	}
+
+	switch {
+	case fn.arity == variadic && arity <= 0:
+		return function{}, 0, evalErrorf(v1pb.WRONG_ARITY, "%s requires the number of arguments", name)
+	case fn.arity == variadic:
+	case arity != 0 && arity != fn.arity:
+		return function{}, 0, evalErrorf(v1pb.WRONG_ARITY, "%s takes %d arguments but %d were given", name, fn.arity, arity)
+	default:
+		arity = fn.arity
+	}
+
+	return fn, arity, nil
+}
+
+// pushStackOp rearranges the values in the stack.
+func (r *rpnEvaluator) pushStackOp(op v1pb.StackOp) error {
 	switch op {
-	case v1pb.ADD:
-		result = v1 + v2
-	case v1pb.SUBTRACT:
-		result = v1 - v2
-	case v1pb.MULTIPLY:
-		result = v1 * v2
-	case v1pb.DIVIDE:
-		result = v1 / v2
+	case v1pb.DUP:
+		if r.ptr < 1 {
+			return errNotEnoughOperands
+		}
+		return r.push(r.stack[r.ptr-1])
+	case v1pb.SWAP:
+		if r.ptr < 2 {
+			return errNotEnoughOperands
+		}
+		r.stack[r.ptr-2], r.stack[r.ptr-1] = r.stack[r.ptr-1], r.stack[r.ptr-2]
+	case v1pb.DROP:
+		if r.ptr < 1 {
+			return errNotEnoughOperands
+		}
+		r.ptr--
+	case v1pb.ROT:
+		// a b c -> b c a
+		if r.ptr < 3 {
+			return errNotEnoughOperands
+		}
+		r.stack[r.ptr-3], r.stack[r.ptr-2], r.stack[r.ptr-1] = r.stack[r.ptr-2], r.stack[r.ptr-1], r.stack[r.ptr-3]
+	case v1pb.CLEAR:
+		r.ptr = 0
 	default:
-		return fmt.Errorf("unimplemented operator: %s", op)
+		return evalErrorf(v1pb.UNKNOWN_OPERATION, "unimplemented stack operation: %s", op)
 	}
 
-	return r.pushOperand(result)
+	return nil
+}
+
+// pushRegister copies the top value to the named register or pushes the value of the register.
+func (r *rpnEvaluator) pushRegister(op v1pb.RegisterOp, name string) error {
+	switch op {
+	case v1pb.STO:
+		if r.ptr < 1 {
+			return errNotEnoughOperands
+		}
+
+		if r.registers == nil {
+			r.registers = make(map[string]value)
+		}
+		r.registers[name] = r.stack[r.ptr-1]
+		return nil
+	case v1pb.RCL:
+		v, ok := r.registers[name]
+		if !ok {
+			return emptyRegisterError(name)
+		}
+		return r.push(v)
+	default:
+		return evalErrorf(v1pb.UNKNOWN_OPERATION, "unimplemented register operation: %s", op)
+	}
+}
+
+func emptyRegisterError(name string) error {
+	return evalErrorf(v1pb.EMPTY_REGISTER, "register %s is empty", name)
+}
+
+// top returns the value at the top of the stack without removing it.
+func (r *rpnEvaluator) top() (float64, bool) {
+	if r.ptr == 0 {
+		return 0, false
+	}
+
+	return r.arithmetic().toFloat64(r.stack[r.ptr-1]), true
+}
+
+// recordStep appends the state of the stack after applying the token to the trace, if tracing is enabled.
+func (r *rpnEvaluator) recordStep(tok *v1pb.Token) {
+	if r.trace == nil || r.trace.Truncated {
+		return
+	}
+
+	if len(r.trace.Steps) >= maxTraceSteps {
+		r.trace.Truncated = true
+		return
+	}
+
+	r.trace.Steps = append(r.trace.Steps, &v1pb.TraceStep{Token: formatToken(tok), Stack: r.formatStack()})
+}
+
+// formatStack returns the stack contents formatted as decimal strings, bottom first.
+func (r *rpnEvaluator) formatStack() []string {
+	stack := make([]string, r.ptr)
+	for i := range stack {
+		stack[i], _ = r.arithmetic().format(r.stack[i])
+	}
+
+	return stack
+}
+
+// formatRegisters returns the values of the registers formatted as decimal strings, or nil if there are none.
+func (r *rpnEvaluator) formatRegisters() map[string]string {
+	if len(r.registers) == 0 {
+		return nil
+	}
+
+	registers := make(map[string]string, len(r.registers))
+	for name, v := range r.registers {
+		registers[name], _ = r.arithmetic().format(v)
+	}
+
+	return registers
+}
+
+// reset empties the stack and the registers so that the evaluator can be reused for another expression.
+func (r *rpnEvaluator) reset() {
+	r.ptr = 0
+	r.registers = nil
+}
+
+func (r *rpnEvaluator) depth() int {
+	return r.ptr
+}
+
+// values returns a copy of the stack contents as float64, bottom first.
+func (r *rpnEvaluator) values() []float64 {
+	vals := make([]float64, r.ptr)
+	for i := range vals {
+		vals[i] = r.arithmetic().toFloat64(r.stack[i])
+	}
+
+	return vals
 }
 
 func (r *rpnEvaluator) result() (float64, error) {
+	v, err := r.resultValue()
+	if err != nil {
+		return 0, err
+	}
+
+	return r.arithmetic().toFloat64(v), nil
+}
+
+// resultValue returns the result in the representation of the arithmetic in use.
+func (r *rpnEvaluator) resultValue() (value, error) {
 	if r.ptr != 1 {
-		return 0, errors.New("incomplete expression: unused operands still in stack")
+		return nil, errIncomplete
 	}
 
 	return r.pop(), nil
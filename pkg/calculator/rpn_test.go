@@ -1,6 +1,7 @@
 package calculator
 
 import (
+	"math"
 	"testing"
 
 	"github.com/charithe/calculator/pkg/v1pb"
@@ -9,8 +10,8 @@ import (
 
 func TestRPNEvaluator(t *testing.T) {
 	t.Run("pushOperand", func(t *testing.T) {
-		rpn := &rpnEvaluator{}
-		for i := 0; i < stackSize-1; i++ {
+		rpn := &rpnEvaluator{maxDepth: 16}
+		for i := 0; i < 16; i++ {
 			require.NoError(t, rpn.pushOperand(24))
 		}
 
@@ -18,6 +19,21 @@ func TestRPNEvaluator(t *testing.T) {
 		require.Error(t, rpn.pushOperand(24))
 	})
 
+	t.Run("pushOperandUnbounded", func(t *testing.T) {
+		rpn := &rpnEvaluator{}
+		for i := 0; i < 10000; i++ {
+			require.NoError(t, rpn.pushOperand(float64(i)))
+		}
+
+		for i := 0; i < 9999; i++ {
+			require.NoError(t, rpn.pushOperator(v1pb.ADD))
+		}
+
+		haveResult, err := rpn.result()
+		require.NoError(t, err)
+		require.Equal(t, float64(9999*10000/2), haveResult)
+	})
+
 	t.Run("pushOperator", func(t *testing.T) {
 		rpn := &rpnEvaluator{}
 		rpn.pushOperand(10)
@@ -28,6 +44,137 @@ func TestRPNEvaluator(t *testing.T) {
 		require.Error(t, rpn.pushOperator(v1pb.SUBTRACT))
 	})
 
+	t.Run("rejectedOperatorLeavesStackIntact", func(t *testing.T) {
+		rpn := &rpnEvaluator{}
+		rpn.pushOperand(10)
+		rpn.pushOperand(20)
+
+		require.Error(t, rpn.pushOperator(v1pb.UNDEFINED))
+		require.Equal(t, 2, rpn.depth())
+
+		top, ok := rpn.top()
+		require.True(t, ok)
+		require.Equal(t, float64(20), top)
+	})
+
+	t.Run("pushFunction", func(t *testing.T) {
+		testCases := []struct {
+			name       string
+			operands   []float64
+			function   string
+			arity      int
+			wantResult float64
+			wantErr    bool
+		}{
+			{name: "neg", operands: []float64{3}, function: "neg", wantResult: -3},
+			{name: "sqrt", operands: []float64{9}, function: "SQRT", wantResult: 3},
+			{name: "sqrt_negative", operands: []float64{-9}, function: "sqrt", wantErr: true},
+			{name: "ln_negative", operands: []float64{-1}, function: "ln", wantErr: true},
+			{name: "asin_out_of_range", operands: []float64{2}, function: "asin", wantErr: true},
+			{name: "atan2_argument_order", operands: []float64{0, -1}, function: "atan2", wantResult: math.Pi},
+			{name: "explicit_arity", operands: []float64{3, 4}, function: "hypot", arity: 2, wantResult: 5},
+			{name: "wrong_arity", operands: []float64{3, 4}, function: "hypot", arity: 3, wantErr: true},
+			{name: "variadic", operands: []float64{1, 5, 3}, function: "max", arity: 3, wantResult: 5},
+			{name: "variadic_partial", operands: []float64{1, 5, 3}, function: "sum", arity: 2, wantResult: 8},
+			{name: "variadic_without_arity", operands: []float64{1, 5, 3}, function: "avg", wantErr: true},
+			{name: "not_enough_operands", operands: []float64{1}, function: "max", arity: 2, wantErr: true},
+			{name: "constant", function: "pi", wantResult: math.Pi},
+			{name: "unknown", operands: []float64{1}, function: "foo", wantErr: true},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rpn := &rpnEvaluator{}
+				for _, v := range tc.operands {
+					require.NoError(t, rpn.pushOperand(v))
+				}
+
+				err := rpn.pushFunction(tc.function, tc.arity)
+				if tc.wantErr {
+					require.Error(t, err)
+					require.Equal(t, len(tc.operands), rpn.depth())
+					return
+				}
+
+				require.NoError(t, err)
+				top, _ := rpn.top()
+				require.Equal(t, tc.wantResult, top)
+			})
+		}
+	})
+
+	t.Run("pushStackOp", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			operands  []float64
+			op        v1pb.StackOp
+			wantStack []float64
+			wantErr   bool
+		}{
+			{name: "dup", operands: []float64{1, 2}, op: v1pb.DUP, wantStack: []float64{1, 2, 2}},
+			{name: "dup_empty", op: v1pb.DUP, wantErr: true},
+			{name: "swap", operands: []float64{1, 2, 3}, op: v1pb.SWAP, wantStack: []float64{1, 3, 2}},
+			{name: "swap_single", operands: []float64{1}, op: v1pb.SWAP, wantErr: true},
+			{name: "drop", operands: []float64{1, 2}, op: v1pb.DROP, wantStack: []float64{1}},
+			{name: "drop_empty", op: v1pb.DROP, wantErr: true},
+			{name: "rot", operands: []float64{1, 2, 3, 4}, op: v1pb.ROT, wantStack: []float64{1, 3, 4, 2}},
+			{name: "rot_two", operands: []float64{1, 2}, op: v1pb.ROT, wantErr: true},
+			{name: "clear", operands: []float64{1, 2, 3}, op: v1pb.CLEAR, wantStack: []float64{}},
+			{name: "undefined", operands: []float64{1}, op: v1pb.STACK_OP_UNDEFINED, wantErr: true},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rpn := &rpnEvaluator{}
+				for _, v := range tc.operands {
+					require.NoError(t, rpn.pushOperand(v))
+				}
+
+				err := rpn.pushStackOp(tc.op)
+				if tc.wantErr {
+					require.Error(t, err)
+					require.Equal(t, len(tc.operands), rpn.depth())
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, tc.wantStack, rpn.values())
+			})
+		}
+	})
+
+	t.Run("dupOnFullStack", func(t *testing.T) {
+		rpn := &rpnEvaluator{maxDepth: 2}
+		require.NoError(t, rpn.pushOperand(24))
+		require.NoError(t, rpn.pushOperand(24))
+
+		require.Equal(t, errStackFull, rpn.pushStackOp(v1pb.DUP))
+	})
+
+	t.Run("invalidOperands", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			operands []float64
+			operator v1pb.Operator
+		}{
+			{name: "pow_negative_base_fractional_exponent", operands: []float64{-8, 1.0 / 3}, operator: v1pb.POW},
+			{name: "mod_by_zero", operands: []float64{7, 0}, operator: v1pb.MOD},
+			{name: "idiv_by_zero", operands: []float64{7, 0}, operator: v1pb.IDIV},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rpn := &rpnEvaluator{}
+				for _, v := range tc.operands {
+					require.NoError(t, rpn.pushOperand(v))
+				}
+
+				require.Error(t, rpn.pushOperator(tc.operator))
+				require.Equal(t, len(tc.operands), rpn.depth())
+			})
+		}
+	})
+
 	t.Run("resultCalculation", func(t *testing.T) {
 		testCases := []struct {
 			name       string
@@ -59,6 +206,72 @@ func TestRPNEvaluator(t *testing.T) {
 				operators:  []v1pb.Operator{v1pb.DIVIDE},
 				wantResult: 5,
 			},
+			{
+				name:       "pow",
+				operands:   []float64{2, 10},
+				operators:  []v1pb.Operator{v1pb.POW},
+				wantResult: 1024,
+			},
+			{
+				name:       "pow_negative_base_integer_exponent",
+				operands:   []float64{-2, 3},
+				operators:  []v1pb.Operator{v1pb.POW},
+				wantResult: -8,
+			},
+			{
+				name:       "pow_fractional_exponent",
+				operands:   []float64{16, 0.5},
+				operators:  []v1pb.Operator{v1pb.POW},
+				wantResult: 4,
+			},
+			{
+				name:       "pow_negative_exponent",
+				operands:   []float64{2, -2},
+				operators:  []v1pb.Operator{v1pb.POW},
+				wantResult: 0.25,
+			},
+			{
+				name:       "mod",
+				operands:   []float64{7, 3},
+				operators:  []v1pb.Operator{v1pb.MOD},
+				wantResult: 1,
+			},
+			{
+				name:       "mod_negative_dividend",
+				operands:   []float64{-7, 3},
+				operators:  []v1pb.Operator{v1pb.MOD},
+				wantResult: 2,
+			},
+			{
+				name:       "mod_negative_divisor",
+				operands:   []float64{7, -3},
+				operators:  []v1pb.Operator{v1pb.MOD},
+				wantResult: -2,
+			},
+			{
+				name:       "mod_fractional",
+				operands:   []float64{5.5, 2},
+				operators:  []v1pb.Operator{v1pb.MOD},
+				wantResult: 1.5,
+			},
+			{
+				name:       "idiv",
+				operands:   []float64{7, 2},
+				operators:  []v1pb.Operator{v1pb.IDIV},
+				wantResult: 3,
+			},
+			{
+				name:       "idiv_negative",
+				operands:   []float64{-7, 2},
+				operators:  []v1pb.Operator{v1pb.IDIV},
+				wantResult: -4,
+			},
+			{
+				name:       "idiv_fractional",
+				operands:   []float64{7.5, 2.5},
+				operators:  []v1pb.Operator{v1pb.IDIV},
+				wantResult: 3,
+			},
 			{
 				name:       "multiply_subract_add",
 				operands:   []float64{10, 2, 5, 9},
@@ -85,4 +298,15 @@ func TestRPNEvaluator(t *testing.T) {
 			})
 		}
 	})
+	t.Run("traceTruncated", func(t *testing.T) {
+		rpn := &rpnEvaluator{trace: &v1pb.Trace{}}
+		tok := &v1pb.Token{Token: &v1pb.Token_Operand{Operand: &v1pb.Operand{Value: 1}}}
+		for i := 0; i < maxTraceSteps+5; i++ {
+			require.NoError(t, rpn.pushOperand(1))
+			rpn.recordStep(tok)
+		}
+
+		require.Len(t, rpn.trace.Steps, maxTraceSteps)
+		require.True(t, rpn.trace.Truncated)
+	})
 }
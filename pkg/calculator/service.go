@@ -2,40 +2,149 @@ package calculator
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultMaxStackDepth is the maximum stack depth used when the service is created without WithMaxStackDepth.
+const DefaultMaxStackDepth = 1024
+
+const (
+	defaultHistoryPageSize = 100
+	maxHistoryPageSize     = 1000
 )
 
 // Service implements the RPC interface of the calculator
 type Service struct {
 	*health.Server
+	maxStackDepth        int
+	programCacheSize     int
+	programs             *programCache
+	sessionTTL           time.Duration
+	maxSessions          int
+	maxSessionsPerClient int
+	sessions             *sessionStore
+	history              *HistoryStore
+}
+
+// Option configures the Service
+type Option func(*Service)
+
+// WithMaxStackDepth sets the maximum depth of the evaluator stack. Zero removes the limit.
+func WithMaxStackDepth(depth int) Option {
+	return func(s *Service) {
+		s.maxStackDepth = depth
+	}
+}
+
+// WithProgramCacheSize sets the number of compiled programs kept for Execute. The size must be positive.
+func WithProgramCacheSize(size int) Option {
+	return func(s *Service) {
+		s.programCacheSize = size
+	}
+}
+
+// WithSessionTTL sets how long a session is kept after its last use. Zero keeps sessions until they are deleted.
+func WithSessionTTL(ttl time.Duration) Option {
+	return func(s *Service) {
+		s.sessionTTL = ttl
+	}
+}
+
+// WithMaxSessions sets the number of sessions that the service holds at once across all clients. Zero removes the limit.
+func WithMaxSessions(n int) Option {
+	return func(s *Service) {
+		s.maxSessions = n
+	}
+}
+
+// WithMaxSessionsPerClient sets the number of sessions that a client, identified by its IP address, can hold at once.
+// Zero removes the limit.
+func WithMaxSessionsPerClient(n int) Option {
+	return func(s *Service) {
+		s.maxSessionsPerClient = n
+	}
 }
 
-func NewService() *Service {
-	return &Service{
-		Server: health.NewServer(),
+// WithHistory records the outcome of every EvaluateBatch and EvaluateStream call in the store.
+func WithHistory(history *HistoryStore) Option {
+	return func(s *Service) {
+		s.history = history
 	}
 }
 
+func NewService(opts ...Option) *Service {
+	s := &Service{
+		Server:               health.NewServer(),
+		maxStackDepth:        DefaultMaxStackDepth,
+		programCacheSize:     DefaultProgramCacheSize,
+		sessionTTL:           DefaultSessionTTL,
+		maxSessions:          DefaultMaxSessions,
+		maxSessionsPerClient: DefaultMaxSessionsPerClient,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	programs, err := newProgramCache(s.programCacheSize)
+	if err != nil {
+		zap.S().Warnw("Invalid program cache size. Using default.", "size", s.programCacheSize, "error", err)
+		programs, _ = newProgramCache(DefaultProgramCacheSize)
+	}
+	s.programs = programs
+	s.sessions = newSessionStore(s.sessionTTL, s.maxSessions, s.maxSessionsPerClient)
+	go s.sessions.sweep()
+
+	return s
+}
+
+// Close stops removing expired sessions in the background. The service must not be used afterwards.
+func (s *Service) Close() {
+	s.sessions.close()
+}
+
+// stackDepth returns the effective stack limit for a call. Clients can only lower the server limit.
+func (s *Service) stackDepth(requested uint32) int {
+	if requested > 0 && (s.maxStackDepth == 0 || int(requested) < s.maxStackDepth) {
+		return int(requested)
+	}
+
+	return s.maxStackDepth
+}
+
 func (s *Service) EvaluateStream(stream v1pb.Calculator_EvaluateStreamServer) error {
-	rpn := &rpnEvaluator{}
+	rpn := &rpnEvaluator{maxDepth: s.maxStackDepth}
+	entry := &v1pb.HistoryEntry{Method: "EvaluateStream"}
 
-	for {
+	for i := 0; ; i++ {
 		req, err := stream.Recv()
 		if err != nil {
 			if err == io.EOF {
 				// end of the client-side stream so calculate the result
-				result, err := rpn.result()
+				result, err := rpn.resultValue()
 				if err != nil {
-					return grpc.Errorf(codes.InvalidArgument, err.Error())
+					statusErr := evaluatorStatus(rpn, err, -1, nil)
+					s.recordHistory(stream.Context(), entry, rpn.arithmetic(), nil, statusErr)
+					return statusErr
 				}
+				s.recordHistory(stream.Context(), entry, rpn.arithmetic(), result, nil)
 
-				if err := stream.SendAndClose(&v1pb.EvaluateStreamResponse{Result: result}); err != nil {
+				resp := &v1pb.EvaluateStreamResponse{Result: rpn.arithmetic().toFloat64(result), Trace: rpn.trace}
+				resp.ResultDecimal, resp.ResultFraction = rpn.arithmetic().format(result)
+				if err := stream.SendAndClose(resp); err != nil {
 					zap.S().Errorw("Failed to send response", "error", err)
 					return err
 				}
@@ -47,15 +156,65 @@ func (s *Service) EvaluateStream(stream v1pb.Calculator_EvaluateStreamServer) er
 			return err
 		}
 
-		switch v := req.Token.Token.(type) {
-		case *v1pb.Token_Operand:
-			if err := rpn.pushOperand(v.Operand.Value); err != nil {
-				return grpc.Errorf(codes.ResourceExhausted, err.Error())
+		if i == 0 {
+			// the stream is configured by the first message
+			if rpn.arith, err = newArithmetic(req.Precision, req.FloatPrecision); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			rpn.maxDepth = s.stackDepth(req.MaxStackDepth)
+			if req.Explain {
+				rpn.trace = &v1pb.Trace{}
+			}
+			entry.Precision = req.Precision
+		}
+
+		if s.history != nil {
+			if len(entry.Tokens) < maxHistoryTokens {
+				entry.Tokens = append(entry.Tokens, req.Token)
+			} else {
+				entry.Truncated = true
 			}
-		case *v1pb.Token_Operator:
-			if err := rpn.pushOperator(v.Operator); err != nil {
-				return grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+
+		if err := applyToken(rpn, req.Token); err != nil {
+			statusErr := evaluatorStatus(rpn, err, i, req.Token)
+			s.recordHistory(stream.Context(), entry, rpn.arithmetic(), nil, statusErr)
+			return statusErr
+		}
+		rpn.recordStep(req.Token)
+	}
+}
+
+func (s *Service) EvaluateInteractive(stream v1pb.Calculator_EvaluateInteractiveServer) error {
+	rpn := &rpnEvaluator{}
+
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
 			}
+
+			zap.S().Warnw("Failed to receive request from stream", "error", err)
+			return err
+		}
+
+		if first {
+			rpn.maxDepth = s.stackDepth(req.MaxStackDepth)
+		}
+
+		resp := &v1pb.EvaluateInteractiveResponse{}
+		if err := applyToken(rpn, req.Token); err != nil {
+			// the evaluator state is unchanged so the client can carry on with the next token
+			resp.Error = err.Error()
+		}
+
+		resp.StackTop, _ = rpn.top()
+		resp.StackDepth = int32(rpn.depth())
+
+		if err := stream.Send(resp); err != nil {
+			zap.S().Warnw("Failed to send response", "error", err)
+			return err
 		}
 	}
 }
@@ -66,24 +225,581 @@ func (s *Service) EvaluateBatch(ctx context.Context, req *v1pb.EvaluateBatchRequ
 		return nil, err
 	}
 
-	rpn := &rpnEvaluator{}
-	for _, t := range req.Tokens {
-		switch v := t.Token.(type) {
-		case *v1pb.Token_Operand:
-			if err := rpn.pushOperand(v.Operand.Value); err != nil {
-				return nil, grpc.Errorf(codes.ResourceExhausted, err.Error())
-			}
-		case *v1pb.Token_Operator:
-			if err := rpn.pushOperator(v.Operator); err != nil {
-				return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+	arith, err := newArithmetic(req.Precision, req.FloatPrecision)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rpn := &rpnEvaluator{arith: arith, maxDepth: s.stackDepth(req.MaxStackDepth), bindings: req.Bindings}
+	if req.Explain {
+		rpn.trace = &v1pb.Trace{}
+	}
+
+	var result value
+	switch {
+	case req.Expression != nil && len(req.Tokens) > 0:
+		return nil, status.Error(codes.InvalidArgument, "only one of tokens and expression can be set")
+	case req.Expression != nil && !req.Explain:
+		tree := &treeEvaluator{arith: arith, maxDepth: rpn.maxDepth, bindings: req.Bindings}
+		result, err = evaluateTree(tree, req.Expression)
+	case req.Expression != nil:
+		// the trace is recorded by the token evaluator so the tree is converted to the equivalent tokens
+		var tokens []*v1pb.Token
+		if tokens, err = expressionTokens(req.Expression); err == nil {
+			result, err = evaluate(rpn, tokens)
+		}
+	default:
+		result, err = evaluate(rpn, req.Tokens)
+	}
+
+	entry := &v1pb.HistoryEntry{Method: "EvaluateBatch", Tokens: req.Tokens, Expression: req.Expression, Precision: req.Precision}
+	s.recordHistory(ctx, entry, arith, result, err)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1pb.EvaluateBatchResponse{Result: arith.toFloat64(result), Trace: rpn.trace}
+	resp.ResultDecimal, resp.ResultFraction = arith.format(result)
+	return resp, nil
+}
+
+func (s *Service) EvaluateExpression(ctx context.Context, req *v1pb.EvaluateExpressionRequest) (*v1pb.EvaluateExpressionResponse, error) {
+	// if the context has already expired, we can avoid unnecessary work
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	tokens, err := parseExpression(req.Expression, req.Notation)
+	if err != nil {
+		return nil, parseErrorStatus(err)
+	}
+
+	rpn := &rpnEvaluator{maxDepth: s.stackDepth(req.MaxStackDepth), bindings: req.Bindings}
+	result, err := evaluate(rpn, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1pb.EvaluateExpressionResponse{Result: rpn.arithmetic().toFloat64(result)}, nil
+}
+
+func (s *Service) EvaluateVector(ctx context.Context, req *v1pb.EvaluateVectorRequest) (*v1pb.EvaluateVectorResponse, error) {
+	// if the context has already expired, we can avoid unnecessary work
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	prog, err := compile(req.Tokens, s.stackDepth(req.MaxStackDepth))
+	if err != nil {
+		return nil, err
+	}
+
+	columns, rows, err := prog.bind(req.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluateVector(ctx, prog, columns, rows, s.stackDepth(req.MaxStackDepth))
+}
+
+// Compile checks the tokens once and caches the resulting program so that it can be run repeatedly with Execute.
+func (s *Service) Compile(ctx context.Context, req *v1pb.CompileRequest) (*v1pb.CompileResponse, error) {
+	// if the context has already expired, we can avoid unnecessary work
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	prog, err := compile(req.Tokens, s.maxStackDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.programs.add(ctx, prog)
+	if err != nil {
+		zap.S().Errorw("Failed to cache program", "error", err)
+		return nil, status.Error(codes.Internal, "failed to cache program")
+	}
+
+	return &v1pb.CompileResponse{ProgramId: id, StackDepth: uint32(prog.maxDepth)}, nil
+}
+
+func (s *Service) Execute(ctx context.Context, req *v1pb.ExecuteRequest) (*v1pb.ExecuteResponse, error) {
+	// if the context has already expired, we can avoid unnecessary work
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	prog, ok := s.programs.get(ctx, req.ProgramId)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown program: compile the tokens again")
+	}
+
+	arith, err := newArithmetic(req.Precision, req.FloatPrecision)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rpn := &rpnEvaluator{arith: arith, maxDepth: s.maxStackDepth, bindings: req.Bindings}
+	result, err := evaluate(rpn, prog.tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1pb.ExecuteResponse{Result: arith.toFloat64(result)}
+	resp.ResultDecimal, resp.ResultFraction = arith.format(result)
+	return resp, nil
+}
+
+// Validate checks an expression without evaluating it and reports all of the problems found.
+// Problems are reported in the response rather than as an error status.
+func (s *Service) Validate(ctx context.Context, req *v1pb.ValidateRequest) (*v1pb.ValidateResponse, error) {
+	// if the context has already expired, we can avoid unnecessary work
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if req.Expression == "" {
+		return validate(req.Tokens, nil, nil, s.stackDepth(req.MaxStackDepth)), nil
+	}
+
+	var tokens []*v1pb.Token
+	var positions []int
+	invalid := make(map[int]string)
+
+	switch req.Notation {
+	case v1pb.RPN:
+		// every word is checked so that all invalid tokens are reported at once
+		var words []string
+		words, positions = splitRPN(req.Expression)
+		tokens = make([]*v1pb.Token, len(words))
+		for i, word := range words {
+			tok, err := parseToken(word)
+			if err != nil {
+				invalid[i] = word
+				continue
 			}
+			tokens[i] = tok
+		}
+	case v1pb.INFIX:
+		var err error
+		tokens, positions, err = parseInfixPositions(req.Expression)
+		if err != nil {
+			perr := err.(*ParseError)
+			return &v1pb.ValidateResponse{Problems: []*v1pb.ValidationProblem{{
+				Message:  perr.Error(),
+				Error:    &v1pb.EvaluationError{Reason: v1pb.SYNTAX_ERROR, TokenIndex: -1},
+				Position: int32(perr.Pos),
+			}}}, nil
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported notation: %s", req.Notation)
+	}
+
+	return validate(tokens, positions, invalid, s.stackDepth(req.MaxStackDepth)), nil
+}
+
+// Convert rewrites an expression or a list of tokens in the target notation.
+func (s *Service) Convert(ctx context.Context, req *v1pb.ConvertRequest) (*v1pb.ConvertResponse, error) {
+	// if the context has already expired, we can avoid unnecessary work
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	tokens := req.Tokens
+	if req.Expression != "" {
+		var err error
+		tokens, err = parseExpression(req.Expression, req.Notation)
+		if err != nil {
+			return nil, parseErrorStatus(err)
+		}
+	}
+
+	resp := &v1pb.ConvertResponse{Tokens: tokens}
+	switch req.TargetNotation {
+	case v1pb.RPN:
+		if _, err := compile(tokens, 0); err != nil {
+			return nil, err
+		}
+		resp.Expression = formatRPN(tokens)
+	case v1pb.INFIX:
+		expr, err := infixString(tokens)
+		if err != nil {
+			return nil, err
+		}
+		resp.Expression = expr
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported notation: %s", req.TargetNotation)
+	}
+
+	return resp, nil
+}
+
+// CreateSession starts a session whose stack and registers are kept by the server between calls. The session is not
+// tied to the client that created it: anyone who knows the returned ID can use or delete it.
+func (s *Service) CreateSession(ctx context.Context, req *v1pb.CreateSessionRequest) (*v1pb.CreateSessionResponse, error) {
+	// if the context has already expired, we can avoid unnecessary work
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	arith, err := newArithmetic(req.Precision, req.FloatPrecision)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rpn := &rpnEvaluator{arith: arith, maxDepth: s.stackDepth(req.MaxStackDepth)}
+	sess, err := s.sessions.create(clientAddr(ctx), rpn)
+	if err != nil {
+		switch err {
+		case errTooManySessions:
+			return nil, status.Errorf(codes.ResourceExhausted, "too many sessions: at most %d are allowed on the server", s.maxSessions)
+		case errTooManyClientSessions:
+			return nil, status.Errorf(codes.ResourceExhausted, "too many sessions: at most %d are allowed per client", s.maxSessionsPerClient)
+		}
+
+		zap.S().Errorw("Failed to create session", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create session")
+	}
+
+	sess.Lock()
+	defer sess.Unlock()
+	return &v1pb.CreateSessionResponse{Session: sessionState(sess)}, nil
+}
+
+// Push applies the tokens to the stack of a session. The session is left unchanged if any of the tokens fails.
+func (s *Service) Push(ctx context.Context, req *v1pb.PushRequest) (*v1pb.PushResponse, error) {
+	// if the context has already expired, we can avoid unnecessary work
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sess, ok := s.sessions.get(req.SessionId)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown session: it may have expired")
+	}
+
+	sess.Lock()
+	defer sess.Unlock()
+
+	rpn := sess.rpn
+	stack := append([]value(nil), rpn.stack[:rpn.ptr]...)
+	registers := make(map[string]value, len(rpn.registers))
+	for name, v := range rpn.registers {
+		registers[name] = v
+	}
+
+	for i, t := range req.Tokens {
+		if err := applyToken(rpn, t); err != nil {
+			statusErr := evaluationStatus(err, i, t, rpn.depth(), rpn.maxDepth)
+			rpn.stack, rpn.ptr, rpn.registers = stack, len(stack), registers
+			return nil, statusErr
+		}
+	}
+
+	return &v1pb.PushResponse{Session: sessionState(sess)}, nil
+}
+
+func (s *Service) GetSession(ctx context.Context, req *v1pb.GetSessionRequest) (*v1pb.GetSessionResponse, error) {
+	sess, ok := s.sessions.get(req.SessionId)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown session: it may have expired")
+	}
+
+	sess.Lock()
+	defer sess.Unlock()
+	return &v1pb.GetSessionResponse{Session: sessionState(sess)}, nil
+}
+
+func (s *Service) DeleteSession(ctx context.Context, req *v1pb.DeleteSessionRequest) (*v1pb.DeleteSessionResponse, error) {
+	if !s.sessions.delete(req.SessionId) {
+		return nil, status.Error(codes.NotFound, "unknown session: it may have expired")
+	}
+
+	return &v1pb.DeleteSessionResponse{}, nil
+}
+
+// ListHistory returns the evaluations recorded in the history store in the order they were performed. The caller of
+// each evaluation is only identified by the IP address of the peer, so clients behind the same NAT or proxy cannot be
+// told apart.
+func (s *Service) ListHistory(ctx context.Context, req *v1pb.ListHistoryRequest) (*v1pb.ListHistoryResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "history is not enabled on this server")
+	}
+
+	var afterID uint64
+	if req.PageToken != "" {
+		id, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
 		}
+		afterID = id
+	}
+
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize == 0:
+		pageSize = defaultHistoryPageSize
+	case pageSize > maxHistoryPageSize:
+		pageSize = maxHistoryPageSize
+	}
+
+	var start, end time.Time
+	if req.StartTimeUnixNano != 0 {
+		start = time.Unix(0, req.StartTimeUnixNano)
+	}
+	if req.EndTimeUnixNano != 0 {
+		end = time.Unix(0, req.EndTimeUnixNano)
 	}
 
-	result, err := rpn.result()
+	entries, more, err := s.history.List(start, end, afterID, pageSize)
 	if err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		zap.S().Errorw("Failed to list history entries", "error", err)
+		return nil, status.Error(codes.Internal, "failed to read history")
+	}
+
+	resp := &v1pb.ListHistoryResponse{Entries: entries}
+	if more {
+		resp.NextPageToken = encodePageToken(entries[len(entries)-1].Id)
 	}
 
-	return &v1pb.EvaluateBatchResponse{Result: result}, nil
+	return resp, nil
+}
+
+// recordHistory completes the entry with the outcome of the evaluation and adds it to the history, if enabled.
+// Failing to record the entry does not fail the evaluation.
+func (s *Service) recordHistory(ctx context.Context, entry *v1pb.HistoryEntry, arith arithmetic, result value, err error) {
+	if s.history == nil {
+		return
+	}
+
+	entry.Caller = clientAddr(ctx)
+	if err != nil {
+		st := status.Convert(err)
+		entry.Code = uint32(st.Code())
+		entry.Error = st.Message()
+	} else {
+		entry.Result = arith.toFloat64(result)
+		entry.ResultDecimal, _ = arith.format(result)
+	}
+
+	if err := s.history.Record(entry); err != nil {
+		zap.S().Errorw("Failed to record history entry", "method", entry.Method, "error", err)
+	}
+}
+
+func encodePageToken(lastID uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(lastID, 10)))
+}
+
+func decodePageToken(token string) (uint64, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(string(buf), 10, 64)
+}
+
+// sessionState describes the stack and registers of the session. The caller must hold the lock of the session.
+func sessionState(sess *session) *v1pb.Session {
+	return &v1pb.Session{Id: sess.id, Stack: sess.rpn.formatStack(), Registers: sess.rpn.formatRegisters()}
+}
+
+// validate reports the problems found by analyze. positions holds the byte offset of each token in the source
+// expression and may be nil. invalid holds the text of the words that could not be tokenized by index, which are nil
+// in tokens.
+func validate(tokens []*v1pb.Token, positions []int, invalid map[int]string, maxDepth int) *v1pb.ValidateResponse {
+	reached, problems := analyze(tokens, maxDepth)
+	resp := &v1pb.ValidateResponse{Valid: len(problems) == 0, MaxStackDepth: uint32(reached)}
+
+	for _, p := range problems {
+		var tok *v1pb.Token
+		if p.index >= 0 {
+			tok = tokens[p.index]
+		}
+
+		problem := &v1pb.ValidationProblem{
+			Message:  errorMessage(p.err, maxDepth),
+			Error:    evaluationDetail(p.err, p.index, tok, p.depth),
+			Position: -1,
+		}
+
+		if word, ok := invalid[p.index]; ok {
+			problem.Message = fmt.Sprintf("invalid token %q", word)
+			problem.Error.Reason = v1pb.UNKNOWN_OPERATION
+			problem.Error.Token = word
+		}
+
+		if p.index >= 0 && p.index < len(positions) {
+			problem.Position = int32(positions[p.index])
+		}
+
+		resp.Problems = append(resp.Problems, problem)
+	}
+
+	return resp
+}
+
+// evaluate runs the tokens through the evaluator and returns the result or a gRPC status error.
+func evaluate(rpn *rpnEvaluator, tokens []*v1pb.Token) (value, error) {
+	// report all missing bindings at once rather than one at a time
+	bound := func(name string) bool {
+		_, ok := rpn.bindings[name]
+		return ok
+	}
+
+	if names, first := unboundVariables(tokens, bound); len(names) > 0 {
+		err := evalErrorf(v1pb.UNBOUND_VARIABLE, "unbound variables: %s", strings.Join(names, ", "))
+		return nil, evaluatorStatus(rpn, err, first, tokens[first])
+	}
+
+	for i, t := range tokens {
+		if err := applyToken(rpn, t); err != nil {
+			return nil, evaluatorStatus(rpn, err, i, t)
+		}
+		rpn.recordStep(t)
+	}
+
+	result, err := rpn.resultValue()
+	if err != nil {
+		return nil, evaluatorStatus(rpn, err, -1, nil)
+	}
+
+	return result, nil
+}
+
+// applyToken pushes the token to the evaluator and returns an error if it cannot be applied.
+func applyToken(rpn *rpnEvaluator, t *v1pb.Token) error {
+	var err error
+	switch v := t.GetToken().(type) {
+	case *v1pb.Token_Operand:
+		err = rpn.pushOperandToken(v.Operand)
+	case *v1pb.Token_Operator:
+		err = rpn.pushOperator(v.Operator)
+	case *v1pb.Token_Function:
+		err = rpn.pushFunction(v.Function.GetName(), int(v.Function.GetArity()))
+	case *v1pb.Token_StackOp:
+		err = rpn.pushStackOp(v.StackOp)
+	case *v1pb.Token_Variable:
+		err = rpn.pushVariable(v.Variable.GetName())
+	case *v1pb.Token_Register:
+		err = rpn.pushRegister(v.Register.GetOp(), v.Register.GetName())
+	default:
+		return newEvalError(v1pb.UNKNOWN_OPERATION, "empty token")
+	}
+
+	return err
+}
+
+// unboundVariables returns the names of the variables without a binding in order of appearance, along with the index
+// of the first token referencing one of them.
+func unboundVariables(tokens []*v1pb.Token, bound func(name string) bool) ([]string, int) {
+	var names []string
+	first := -1
+	seen := make(map[string]bool)
+
+	for i, t := range tokens {
+		name := t.GetVariable().GetName()
+		if t.GetVariable() == nil || seen[name] {
+			continue
+		}
+
+		if !bound(name) {
+			seen[name] = true
+			names = append(names, name)
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+
+	return names, first
+}
+
+// evaluatorStatus is evaluationStatus for a failure of the evaluator, with the trace attached if tracing is enabled.
+func evaluatorStatus(rpn *rpnEvaluator, err error, index int, tok *v1pb.Token) error {
+	statusErr := evaluationStatus(err, index, tok, rpn.depth(), rpn.maxDepth)
+	if rpn.trace == nil {
+		return statusErr
+	}
+
+	st, detailErr := status.Convert(statusErr).WithDetails(rpn.trace)
+	if detailErr != nil {
+		zap.S().Warnw("Failed to attach error details", "error", detailErr)
+		return statusErr
+	}
+
+	return st.Err()
+}
+
+// evaluationStatus converts an evaluator error to a gRPC status error.
+// The details identify the failing token by its index in the request or stream, or -1 if the failure is not
+// associated with a token. Stack overflows are reported as ResourceExhausted with a v1pb.StackDepthExceeded detail.
+func evaluationStatus(err error, index int, tok *v1pb.Token, depth, maxDepth int) error {
+	code := codes.InvalidArgument
+	if err == errStackFull {
+		code = codes.ResourceExhausted
+	}
+
+	msg := errorMessage(err, maxDepth)
+
+	details := []proto.Message{evaluationDetail(err, index, tok, depth)}
+
+	if index >= 0 {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: fmt.Sprintf("tokens[%d]", index), Description: msg},
+			},
+		})
+	}
+
+	if err == errStackFull {
+		details = append(details, &v1pb.StackDepthExceeded{Limit: uint32(maxDepth)})
+	}
+
+	st, detailErr := status.New(code, msg).WithDetails(details...)
+	if detailErr != nil {
+		zap.S().Warnw("Failed to attach error details", "error", detailErr)
+		return status.Error(code, msg)
+	}
+
+	return st.Err()
+}
+
+// parseErrorStatus converts a tokenizer error to an InvalidArgument status with a v1pb.ParseError detail.
+func parseErrorStatus(err error) error {
+	perr, ok := err.(*ParseError)
+	if !ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	st, detailErr := status.New(codes.InvalidArgument, perr.Error()).WithDetails(&v1pb.ParseError{
+		Position: int32(perr.Pos),
+		Message:  perr.Msg,
+	})
+	if detailErr != nil {
+		zap.S().Warnw("Failed to attach error details", "error", detailErr)
+		return status.Error(codes.InvalidArgument, perr.Error())
+	}
+
+	return st.Err()
+}
+
+// errorMessage describes an evaluator error to the client.
+func errorMessage(err error, maxDepth int) string {
+	if err == errStackFull {
+		return fmt.Sprintf("stack full: maximum depth of %d exceeded", maxDepth)
+	}
+
+	return err.Error()
+}
+
+// evaluationDetail describes an evaluator error for the status details. See evaluationStatus.
+func evaluationDetail(err error, index int, tok *v1pb.Token, depth int) *v1pb.EvaluationError {
+	return &v1pb.EvaluationError{
+		Reason:     errorReason(err),
+		TokenIndex: int32(index),
+		Token:      formatToken(tok),
+		StackDepth: int32(depth),
+	}
 }
@@ -0,0 +1,220 @@
+package calculator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/peer"
+)
+
+// DefaultSessionTTL is how long an idle session is kept when the service is created without WithSessionTTL.
+const DefaultSessionTTL = 15 * time.Minute
+
+// DefaultMaxSessions is the number of sessions the server holds at once when the service is created without
+// WithMaxSessions.
+const DefaultMaxSessions = 10000
+
+// DefaultMaxSessionsPerClient is the number of sessions a client can hold when the service is created without
+// WithMaxSessionsPerClient.
+const DefaultMaxSessionsPerClient = 16
+
+// maxSessionSweepInterval is the longest time between two scans for expired sessions.
+const maxSessionSweepInterval = time.Minute
+
+var (
+	errTooManySessions       = errors.New("too many sessions")
+	errTooManyClientSessions = errors.New("too many sessions for the client")
+)
+
+// session is the calculator state held by the server between the calls of a client.
+// The ID is the only credential needed to use a session, so it is random and long enough not to be guessed.
+// The evaluator must only be accessed while holding the lock.
+type session struct {
+	sync.Mutex
+	id     string
+	client string
+	rpn    *rpnEvaluator
+	// lastUsed is protected by the lock of the store
+	lastUsed time.Time
+}
+
+// sessionStore holds the sessions by ID and removes the ones that have been idle for longer than the TTL.
+// This is safe for concurrent use.
+type sessionStore struct {
+	ttl          time.Duration
+	maxSessions  int
+	maxPerClient int
+	now          func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	// clients holds the number of sessions of each client
+	clients map[string]int
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newSessionStore(ttl time.Duration, maxSessions, maxPerClient int) *sessionStore {
+	return &sessionStore{
+		ttl:          ttl,
+		maxSessions:  maxSessions,
+		maxPerClient: maxPerClient,
+		now:          time.Now,
+		sessions:     make(map[string]*session),
+		clients:      make(map[string]int),
+		stop:         make(chan struct{}),
+	}
+}
+
+// create adds a session for the client. It fails with errTooManySessions or errTooManyClientSessions if the store or
+// the client already holds the maximum number of sessions.
+func (s *sessionStore) create(client string, rpn *rpnEvaluator) (*session, error) {
+	id, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.full(client) {
+		// expired sessions may not have been swept yet
+		s.expire(now)
+	}
+
+	if s.maxSessions > 0 && len(s.sessions) >= s.maxSessions {
+		return nil, errTooManySessions
+	}
+
+	if s.maxPerClient > 0 && s.clients[client] >= s.maxPerClient {
+		return nil, errTooManyClientSessions
+	}
+
+	sess := &session{id: id, client: client, rpn: rpn, lastUsed: now}
+	s.sessions[id] = sess
+	s.clients[client]++
+	return sess, nil
+}
+
+// full reports whether a session cannot be added for the client. The caller must hold the lock.
+func (s *sessionStore) full(client string) bool {
+	return (s.maxSessions > 0 && len(s.sessions) >= s.maxSessions) ||
+		(s.maxPerClient > 0 && s.clients[client] >= s.maxPerClient)
+}
+
+// get returns the session and marks it as used.
+func (s *sessionStore) get(id string) (*session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[id]
+	if !ok {
+		return nil, false
+	}
+
+	now := s.now()
+	if s.expired(sess, now) {
+		s.remove(sess)
+		return nil, false
+	}
+
+	sess.lastUsed = now
+	return sess, true
+}
+
+// delete removes the session and returns false if it does not exist.
+func (s *sessionStore) delete(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[id]
+	if !ok {
+		return false
+	}
+
+	s.remove(sess)
+	return !s.expired(sess, s.now())
+}
+
+// expire removes the sessions that have been idle for longer than the TTL. The caller must hold the lock.
+func (s *sessionStore) expire(now time.Time) {
+	for _, sess := range s.sessions {
+		if s.expired(sess, now) {
+			s.remove(sess)
+		}
+	}
+}
+
+// sweep removes expired sessions periodically until close is called. It returns immediately if sessions never expire.
+func (s *sessionStore) sweep() {
+	if s.ttl <= 0 {
+		return
+	}
+
+	interval := s.ttl
+	if interval > maxSessionSweepInterval {
+		interval = maxSessionSweepInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			s.expire(s.now())
+			s.mu.Unlock()
+		}
+	}
+}
+
+// close stops the sweep.
+func (s *sessionStore) close() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+func (s *sessionStore) expired(sess *session, now time.Time) bool {
+	return s.ttl > 0 && now.Sub(sess.lastUsed) > s.ttl
+}
+
+// remove deletes the session from the store. The caller must hold the lock.
+func (s *sessionStore) remove(sess *session) {
+	delete(s.sessions, sess.id)
+	if s.clients[sess.client]--; s.clients[sess.client] <= 0 {
+		delete(s.clients, sess.client)
+	}
+}
+
+func newSessionID() (string, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf[:]), nil
+}
+
+// clientAddr identifies the caller by its IP address for the purpose of limiting the number of sessions.
+func clientAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
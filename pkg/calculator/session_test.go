@@ -0,0 +1,135 @@
+package calculator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStore(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	store := newSessionStore(time.Minute, 0, 2)
+	store.now = func() time.Time { return now }
+
+	s1, err := store.create("10.0.0.1", &rpnEvaluator{})
+	require.NoError(t, err)
+
+	s2, err := store.create("10.0.0.1", &rpnEvaluator{})
+	require.NoError(t, err)
+	require.NotEqual(t, s1.id, s2.id)
+
+	// the client is at the limit but other clients are not affected
+	_, err = store.create("10.0.0.1", &rpnEvaluator{})
+	require.Equal(t, errTooManyClientSessions, err)
+
+	_, err = store.create("10.0.0.2", &rpnEvaluator{})
+	require.NoError(t, err)
+
+	// using a session keeps it alive
+	now = now.Add(50 * time.Second)
+	_, ok := store.get(s1.id)
+	require.True(t, ok)
+
+	now = now.Add(50 * time.Second)
+	_, ok = store.get(s1.id)
+	require.True(t, ok)
+
+	_, ok = store.get(s2.id)
+	require.False(t, ok, "idle session should have expired")
+
+	// the expired session no longer counts towards the limit
+	_, err = store.create("10.0.0.1", &rpnEvaluator{})
+	require.NoError(t, err)
+
+	require.True(t, store.delete(s1.id))
+	require.False(t, store.delete(s1.id))
+
+	_, ok = store.get(s1.id)
+	require.False(t, ok)
+}
+
+func TestSessionStoreLimit(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	store := newSessionStore(time.Minute, 3, 2)
+	store.now = func() time.Time { return now }
+
+	for _, client := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"} {
+		_, err := store.create(client, &rpnEvaluator{})
+		require.NoError(t, err)
+	}
+
+	// the store is full even though no client is at its own limit
+	_, err := store.create("10.0.0.4", &rpnEvaluator{})
+	require.Equal(t, errTooManySessions, err)
+
+	// sessions that have expired but have not been swept yet make room
+	now = now.Add(2 * time.Minute)
+	_, err = store.create("10.0.0.4", &rpnEvaluator{})
+	require.NoError(t, err)
+	require.Len(t, store.sessions, 1)
+}
+
+func TestSessionStoreSweep(t *testing.T) {
+	store := newSessionStore(10*time.Millisecond, 0, 0)
+	go store.sweep()
+	defer store.close()
+
+	sess, err := store.create("10.0.0.1", &rpnEvaluator{})
+	require.NoError(t, err)
+
+	// idle sessions are removed without any further calls to the store
+	swept := func() bool {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		_, ok := store.sessions[sess.id]
+		return !ok && len(store.clients) == 0
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !swept() {
+		require.True(t, time.Now().Before(deadline), "idle session was not swept")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSessionRegisters(t *testing.T) {
+	svc := NewService()
+	defer svc.Close()
+
+	ctx := context.Background()
+	created, err := svc.CreateSession(ctx, &v1pb.CreateSessionRequest{})
+	require.NoError(t, err)
+	id := created.Session.Id
+
+	push := func(tokenStrs ...string) (*v1pb.Session, error) {
+		tokens, err := parseTokens(tokenStrs)
+		require.NoError(t, err)
+
+		resp, err := svc.Push(ctx, &v1pb.PushRequest{SessionId: id, Tokens: tokens})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Session, nil
+	}
+
+	// registers stored by one call can be recalled by the following ones
+	_, err = push("2", "sto:a", "drop")
+	require.NoError(t, err)
+
+	have, err := push("rcl:a", "rcl:a", "*", "sto:b")
+	require.NoError(t, err)
+	require.Equal(t, []string{"4"}, have.Stack)
+	require.Equal(t, map[string]string{"a": "2", "b": "4"}, have.Registers)
+
+	// a failing call does not store registers
+	_, err = push("5", "sto:a", "rcl:c")
+	require.Error(t, err)
+
+	have, err = push("rcl:a", "rcl:b", "+")
+	require.NoError(t, err)
+	require.Equal(t, []string{"4", "6"}, have.Stack)
+	require.Equal(t, map[string]string{"a": "2", "b": "4"}, have.Registers)
+}
@@ -0,0 +1,371 @@
+package calculator
+
+import (
+	"strings"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+)
+
+const (
+	// maxExpressionDepth limits the nesting of expression trees, which are walked recursively.
+	maxExpressionDepth = 10000
+	// maxExpressionNodes limits the number of nodes of expression trees. Subtrees shared by stack and register
+	// operations count once per use because every walk of the tree visits them that many times.
+	maxExpressionNodes = 1 << 20
+)
+
+var (
+	errExpressionTooDeep  = evalErrorf(v1pb.OUT_OF_RANGE, "expression is nested deeper than %d levels", maxExpressionDepth)
+	errExpressionTooLarge = evalErrorf(v1pb.OUT_OF_RANGE, "expression has more than %d nodes", maxExpressionNodes)
+)
+
+// ExpressionToTokens converts an expression tree to the equivalent RPN tokens. Malformed trees, such as calls with the
+// wrong number of arguments, are reported as *EvaluationError.
+func ExpressionToTokens(expr *v1pb.Expression) ([]*v1pb.Token, error) {
+	tokens, err := expressionTokens(expr)
+	if err != nil {
+		return nil, evaluationError(err, nil)
+	}
+
+	return tokens, nil
+}
+
+// TokensToExpression converts RPN tokens to an expression tree. Stack and register operations are expanded, so that dup
+// shares the subtree at the top of the stack. Token lists that do not reduce to a single value or whose tree would have
+// more than maxExpressionNodes nodes, counting shared subtrees once per use, are reported as *EvaluationError.
+func TokensToExpression(tokens []*v1pb.Token) (*v1pb.Expression, error) {
+	expr, err := expressionTree(tokens)
+	if err != nil {
+		return nil, evaluationError(err, nil)
+	}
+
+	return expr, nil
+}
+
+// expressionTokens is ExpressionToTokens returning a gRPC status error with the same details as evaluationStatus.
+func expressionTokens(expr *v1pb.Expression) ([]*v1pb.Token, error) {
+	var tokens []*v1pb.Token
+	err := walkExpression(expr, func(e *v1pb.Expression, index, depth int) error {
+		tok, err := nodeToken(e)
+		if err != nil {
+			return evaluationStatus(err, index, tok, depth, 0)
+		}
+
+		tokens = append(tokens, tok)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// walkExpression calls visit for the nodes of the tree in the order of the equivalent RPN tokens, along with the index
+// of the node in the tokens and the number of values in the stack before it is applied. The tree is walked without
+// recursion and rejected as soon as it turns out to be nested deeper than maxExpressionDepth or to have more than
+// maxExpressionNodes nodes. Errors returned by visit are passed through.
+func walkExpression(expr *v1pb.Expression, visit func(e *v1pb.Expression, index, depth int) error) error {
+	type level struct {
+		expr     *v1pb.Expression
+		children []*v1pb.Expression
+		next     int
+	}
+
+	pending := []level{{expr: expr, children: subexpressions(expr)}}
+	index, depth, nodes := 0, 0, 1
+	for len(pending) > 0 {
+		l := &pending[len(pending)-1]
+		if l.next < len(l.children) {
+			child := l.children[l.next]
+			l.next++
+
+			if nodes++; nodes > maxExpressionNodes {
+				return evaluationStatus(errExpressionTooLarge, -1, nil, 0, 0)
+			}
+
+			if len(pending) >= maxExpressionDepth {
+				return evaluationStatus(errExpressionTooDeep, -1, nil, 0, 0)
+			}
+
+			pending = append(pending, level{expr: child, children: subexpressions(child)})
+			continue
+		}
+
+		e, consumed := l.expr, len(l.children)
+		pending = pending[:len(pending)-1]
+		if err := visit(e, index, depth); err != nil {
+			return err
+		}
+
+		index++
+		depth += 1 - consumed
+	}
+
+	return nil
+}
+
+// subexpressions returns the operands of a node in evaluation order.
+func subexpressions(e *v1pb.Expression) []*v1pb.Expression {
+	switch v := e.GetExpression().(type) {
+	case *v1pb.Expression_Binary:
+		return []*v1pb.Expression{v.Binary.Left, v.Binary.Right}
+	case *v1pb.Expression_Call:
+		return v.Call.Args
+	default:
+		return nil
+	}
+}
+
+// nodeToken returns the RPN token of a single node. Malformed nodes are reported along with the token that describes
+// them best, which is nil for empty expressions.
+func nodeToken(e *v1pb.Expression) (*v1pb.Token, error) {
+	switch v := e.GetExpression().(type) {
+	case *v1pb.Expression_Literal:
+		return &v1pb.Token{Token: &v1pb.Token_Operand{Operand: v.Literal}}, nil
+	case *v1pb.Expression_Variable:
+		return &v1pb.Token{Token: &v1pb.Token_Variable{Variable: v.Variable}}, nil
+	case *v1pb.Expression_Binary:
+		return &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v.Binary.Operator}}, nil
+	case *v1pb.Expression_Call:
+		fn, err := callFunction(v.Call)
+		if err != nil {
+			return &v1pb.Token{Token: &v1pb.Token_Function{Function: &v1pb.Function{Name: v.Call.Name, Arity: int32(len(v.Call.Args))}}}, err
+		}
+		return &v1pb.Token{Token: &v1pb.Token_Function{Function: fn}}, nil
+	default:
+		return nil, newEvalError(v1pb.UNKNOWN_OPERATION, "empty expression")
+	}
+}
+
+// expressionTree is TokensToExpression returning a gRPC status error with the same details as evaluationStatus.
+func expressionTree(tokens []*v1pb.Token) (*v1pb.Expression, error) {
+	var stack []*v1pb.Expression
+	registers := make(map[string]*v1pb.Expression)
+	// sizes holds the number of nodes of each subtree when shared subtrees are counted once per use
+	sizes := make(map[*v1pb.Expression]int)
+	for i, t := range tokens {
+		needs, _, err := stackEffect(t)
+		if err == nil && len(stack) < needs {
+			err = errNotEnoughOperands
+		}
+
+		if err != nil {
+			return nil, evaluationStatus(err, i, t, len(stack), 0)
+		}
+
+		var node *v1pb.Expression
+		switch v := t.GetToken().(type) {
+		case *v1pb.Token_Operand:
+			node = &v1pb.Expression{Expression: &v1pb.Expression_Literal{Literal: v.Operand}}
+		case *v1pb.Token_Variable:
+			node = &v1pb.Expression{Expression: &v1pb.Expression_Variable{Variable: v.Variable}}
+		case *v1pb.Token_Operator:
+			node = &v1pb.Expression{Expression: &v1pb.Expression_Binary{Binary: &v1pb.BinaryExpression{
+				Operator: v.Operator,
+				Left:     stack[len(stack)-2],
+				Right:    stack[len(stack)-1],
+			}}}
+		case *v1pb.Token_Function:
+			name := strings.ToLower(v.Function.GetName())
+			_, arity, _ := lookupFunction(name, int(v.Function.GetArity()))
+			// the arguments are copied because the stack is reused by the following tokens
+			args := append([]*v1pb.Expression(nil), stack[len(stack)-arity:]...)
+			node = &v1pb.Expression{Expression: &v1pb.Expression_Call{Call: &v1pb.Call{Name: name, Args: args}}}
+		case *v1pb.Token_StackOp:
+			stack = rearrangeExpressions(stack, v.StackOp)
+			continue
+		case *v1pb.Token_Register:
+			if v.Register.GetOp() == v1pb.STO {
+				registers[v.Register.GetName()] = stack[len(stack)-1]
+				continue
+			}
+
+			var ok bool
+			if node, ok = registers[v.Register.GetName()]; !ok {
+				return nil, evaluationStatus(emptyRegisterError(v.Register.GetName()), i, t, len(stack), 0)
+			}
+		}
+
+		if _, ok := sizes[node]; !ok {
+			size := 1
+			for _, sub := range subexpressions(node) {
+				size += sizes[sub]
+			}
+
+			if size > maxExpressionNodes {
+				return nil, evaluationStatus(errExpressionTooLarge, i, t, len(stack), 0)
+			}
+			sizes[node] = size
+		}
+
+		stack = append(stack[:len(stack)-needs], node)
+	}
+
+	if len(stack) != 1 {
+		return nil, evaluationStatus(errIncomplete, -1, nil, len(stack), 0)
+	}
+
+	return stack[0], nil
+}
+
+// rearrangeExpressions applies a stack operation to the subtrees. The number of operands has already been checked.
+func rearrangeExpressions(stack []*v1pb.Expression, op v1pb.StackOp) []*v1pb.Expression {
+	n := len(stack)
+	switch op {
+	case v1pb.DUP:
+		return append(stack, stack[n-1])
+	case v1pb.SWAP:
+		stack[n-2], stack[n-1] = stack[n-1], stack[n-2]
+	case v1pb.DROP:
+		return stack[:n-1]
+	case v1pb.ROT:
+		stack[n-3], stack[n-2], stack[n-1] = stack[n-2], stack[n-1], stack[n-3]
+	case v1pb.CLEAR:
+		return stack[:0]
+	}
+
+	return stack
+}
+
+// callFunction returns the function token for a call in the form produced by the infix parser, where the arity is
+// only set for variadic functions.
+func callFunction(call *v1pb.Call) (*v1pb.Function, error) {
+	name := strings.ToLower(call.Name)
+	fn, ok := functions[name]
+	argc := len(call.Args)
+
+	switch {
+	case !ok:
+		return nil, evalErrorf(v1pb.UNKNOWN_OPERATION, "unknown function: %s", call.Name)
+	case fn.arity == variadic && argc == 0:
+		return nil, evalErrorf(v1pb.WRONG_ARITY, "%s requires at least one argument", name)
+	case fn.arity == variadic:
+		return &v1pb.Function{Name: name, Arity: int32(argc)}, nil
+	case fn.arity != argc:
+		return nil, evalErrorf(v1pb.WRONG_ARITY, "%s takes %d arguments but %d were given", name, fn.arity, argc)
+	default:
+		return &v1pb.Function{Name: name}, nil
+	}
+}
+
+// treeEvaluator evaluates an expression tree by walking it. The evaluator keeps track of the position and stack depth
+// of each node in the equivalent RPN tokens so that failures are reported exactly as they would be for the tokens.
+// This is not thread-safe and should only be accessed by a single goroutine.
+type treeEvaluator struct {
+	arith    arithmetic
+	maxDepth int
+	bindings map[string]*v1pb.Operand
+	// index is the position of the next node in the equivalent tokens
+	index int
+	// depth is the number of values in the stack when evaluating the equivalent tokens
+	depth int
+}
+
+func (t *treeEvaluator) arithmetic() arithmetic {
+	if t.arith == nil {
+		return floatArithmetic{}
+	}
+
+	return t.arith
+}
+
+// evaluateTree evaluates the expression tree and returns the result or a gRPC status error.
+func evaluateTree(t *treeEvaluator, expr *v1pb.Expression) (value, error) {
+	// the tree is checked up front so that malformed trees and missing bindings are reported before any evaluation, as
+	// they are for tokens
+	var variables []*v1pb.Token
+	var indexes []int
+	err := walkExpression(expr, func(e *v1pb.Expression, index, depth int) error {
+		tok, err := nodeToken(e)
+		if err != nil {
+			return evaluationStatus(err, index, tok, depth, 0)
+		}
+
+		if tok.GetVariable() != nil {
+			variables = append(variables, tok)
+			indexes = append(indexes, index)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bound := func(name string) bool {
+		_, ok := t.bindings[name]
+		return ok
+	}
+
+	if names, first := unboundVariables(variables, bound); len(names) > 0 {
+		err := evalErrorf(v1pb.UNBOUND_VARIABLE, "unbound variables: %s", strings.Join(names, ", "))
+		return nil, evaluationStatus(err, indexes[first], variables[first], t.depth, t.maxDepth)
+	}
+
+	return t.eval(expr)
+}
+
+func (t *treeEvaluator) eval(e *v1pb.Expression) (value, error) {
+	switch v := e.GetExpression().(type) {
+	case *v1pb.Expression_Literal:
+		val, err := t.arithmetic().operand(v.Literal)
+		return t.complete(val, err, 0, &v1pb.Token{Token: &v1pb.Token_Operand{Operand: v.Literal}})
+	case *v1pb.Expression_Variable:
+		tok := &v1pb.Token{Token: &v1pb.Token_Variable{Variable: v.Variable}}
+		o, ok := t.bindings[v.Variable.GetName()]
+		if !ok {
+			return t.complete(nil, evalErrorf(v1pb.UNBOUND_VARIABLE, "unbound variable: %s", v.Variable.GetName()), 0, tok)
+		}
+
+		val, err := t.arithmetic().operand(o)
+		return t.complete(val, err, 0, tok)
+	case *v1pb.Expression_Binary:
+		x, err := t.eval(v.Binary.Left)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := t.eval(v.Binary.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := t.arithmetic().binary(v.Binary.Operator, x, y)
+		return t.complete(result, err, 2, &v1pb.Token{Token: &v1pb.Token_Operator{Operator: v.Binary.Operator}})
+	case *v1pb.Expression_Call:
+		args := make([]value, len(v.Call.Args))
+		for i, arg := range v.Call.Args {
+			val, err := t.eval(arg)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = val
+		}
+
+		fn, err := callFunction(v.Call)
+		if err != nil {
+			tok := &v1pb.Token{Token: &v1pb.Token_Function{Function: &v1pb.Function{Name: v.Call.Name, Arity: int32(len(args))}}}
+			return t.complete(nil, err, len(args), tok)
+		}
+
+		result, err := t.arithmetic().function(fn.Name, functions[fn.Name], args)
+		return t.complete(result, err, len(args), &v1pb.Token{Token: &v1pb.Token_Function{Function: fn}})
+	default:
+		return t.complete(nil, newEvalError(v1pb.UNKNOWN_OPERATION, "empty expression"), 0, nil)
+	}
+}
+
+// complete finishes the evaluation of a node that consumed the given number of values from the equivalent stack.
+func (t *treeEvaluator) complete(v value, err error, consumed int, tok *v1pb.Token) (value, error) {
+	if err == nil && consumed == 0 && t.maxDepth > 0 && t.depth >= t.maxDepth {
+		err = errStackFull
+	}
+
+	if err != nil {
+		return nil, evaluationStatus(err, t.index, tok, t.depth, t.maxDepth)
+	}
+
+	t.index++
+	t.depth += 1 - consumed
+	return v, nil
+}
@@ -0,0 +1,232 @@
+package calculator
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/status"
+)
+
+func TestExpressionTree(t *testing.T) {
+	lit := func(v float64) *v1pb.Expression {
+		return &v1pb.Expression{Expression: &v1pb.Expression_Literal{Literal: &v1pb.Operand{Value: v}}}
+	}
+	variable := func(name string) *v1pb.Expression {
+		return &v1pb.Expression{Expression: &v1pb.Expression_Variable{Variable: &v1pb.Variable{Name: name}}}
+	}
+	bin := func(op v1pb.Operator, left, right *v1pb.Expression) *v1pb.Expression {
+		return &v1pb.Expression{Expression: &v1pb.Expression_Binary{Binary: &v1pb.BinaryExpression{Operator: op, Left: left, Right: right}}}
+	}
+	call := func(name string, args ...*v1pb.Expression) *v1pb.Expression {
+		return &v1pb.Expression{Expression: &v1pb.Expression_Call{Call: &v1pb.Call{Name: name, Args: args}}}
+	}
+	nested := func(depth int) *v1pb.Expression {
+		e := lit(-2)
+		for i := 1; i < depth; i++ {
+			e = call("abs", e)
+		}
+		return e
+	}
+
+	testCases := []struct {
+		name       string
+		expr       *v1pb.Expression
+		wantTokens string
+		wantResult float64
+		wantError  *v1pb.EvaluationError
+	}{
+		{
+			name:       "literal",
+			expr:       lit(5),
+			wantTokens: "5",
+			wantResult: 5,
+		},
+		{
+			name:       "binary",
+			expr:       bin(v1pb.MULTIPLY, bin(v1pb.ADD, lit(5), lit(8)), variable("x")),
+			wantTokens: "5 8 + x *",
+			wantResult: 39,
+		},
+		{
+			name:       "calls",
+			expr:       call("MAX", call("sqrt", lit(16)), call("pi"), bin(v1pb.POW, lit(2), lit(3))),
+			wantTokens: "16 sqrt pi 2 3 ^ max:3",
+			wantResult: 8,
+		},
+		{
+			name:       "deeplyNested",
+			expr:       nested(maxExpressionDepth),
+			wantTokens: "-2" + strings.Repeat(" abs", maxExpressionDepth-1),
+			wantResult: 2,
+		},
+		{
+			name:      "nestedTooDeep",
+			expr:      nested(maxExpressionDepth + 1),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.OUT_OF_RANGE, TokenIndex: -1},
+		},
+		{
+			name:      "divisionByZero",
+			expr:      bin(v1pb.ADD, lit(1), bin(v1pb.MOD, lit(4), lit(0))),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.DIVISION_BY_ZERO, TokenIndex: 3, Token: "%", StackDepth: 3},
+		},
+		{
+			name:      "unboundVariable",
+			expr:      bin(v1pb.ADD, variable("y"), bin(v1pb.ADD, variable("x"), variable("z"))),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.UNBOUND_VARIABLE, TokenIndex: 0, Token: "y"},
+		},
+		{
+			name:      "wrongArity",
+			expr:      bin(v1pb.ADD, lit(1), call("atan2", lit(1))),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.WRONG_ARITY, TokenIndex: 2, Token: "atan2:1", StackDepth: 2},
+		},
+		{
+			name:      "variadicWithoutArguments",
+			expr:      call("max"),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.WRONG_ARITY, TokenIndex: 0, Token: "max"},
+		},
+		{
+			name:      "unknownFunction",
+			expr:      call("foo", lit(1)),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.UNKNOWN_OPERATION, TokenIndex: 1, Token: "foo:1", StackDepth: 1},
+		},
+		{
+			name:      "emptyExpression",
+			expr:      bin(v1pb.ADD, lit(1), nil),
+			wantError: &v1pb.EvaluationError{Reason: v1pb.UNKNOWN_OPERATION, TokenIndex: 1, StackDepth: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bindings := map[string]*v1pb.Operand{"x": {Value: 3}}
+			haveResult, err := evaluateTree(&treeEvaluator{bindings: bindings}, tc.expr)
+			if tc.wantError != nil {
+				require.Error(t, err)
+				require.Contains(t, status.Convert(err).Details(), tc.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantResult, haveResult)
+
+			haveTokens, err := ExpressionToTokens(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantTokens, formatRPN(haveTokens))
+
+			// converting the tokens back must produce the same tree
+			haveExpr, err := TokensToExpression(haveTokens)
+			require.NoError(t, err)
+			haveAgain, err := ExpressionToTokens(haveExpr)
+			require.NoError(t, err)
+			require.Equal(t, haveTokens, haveAgain)
+		})
+	}
+}
+
+func TestTokensToExpressionErrors(t *testing.T) {
+	_, err := TokensToExpression(mustParseRPN(t, "1 2 + +"))
+	eerr, ok := err.(*EvaluationError)
+	require.True(t, ok, "expected *EvaluationError, got %T", err)
+	require.Equal(t, v1pb.NOT_ENOUGH_OPERANDS, eerr.Reason)
+	require.Equal(t, 3, eerr.TokenIndex)
+
+	_, err = TokensToExpression(mustParseRPN(t, "1 2"))
+	eerr, ok = err.(*EvaluationError)
+	require.True(t, ok, "expected *EvaluationError, got %T", err)
+	require.Equal(t, v1pb.INCOMPLETE_EXPRESSION, eerr.Reason)
+}
+
+func TestEvaluateTreeMatchesTokens(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	bindings := map[string]*v1pb.Operand{"x": {Value: 3}, "y": {Value: -0.5}}
+
+	for i := 0; i < 2000; i++ {
+		rpn := randomRPN(r, 5)
+		tokens, err := parseTokens(rpn)
+		require.NoError(t, err)
+
+		expr, err := TokensToExpression(tokens)
+		require.NoError(t, err, "%v", rpn)
+
+		want, wantErr := evaluate(&rpnEvaluator{bindings: bindings}, tokens)
+		have, haveErr := evaluateTree(&treeEvaluator{bindings: bindings}, expr)
+		if wantErr != nil {
+			require.Error(t, haveErr, "%v", rpn)
+			continue
+		}
+
+		require.NoError(t, haveErr, "%v", rpn)
+		if math.IsNaN(want.(float64)) {
+			require.True(t, math.IsNaN(have.(float64)), "%v", rpn)
+			continue
+		}
+		require.Equal(t, want, have, "%v", rpn)
+	}
+}
+
+func TestEvaluateTreeMaxStackDepth(t *testing.T) {
+	tokens := mustParseRPN(t, "1 2 3 4 + + +")
+	expr, err := TokensToExpression(tokens)
+	require.NoError(t, err)
+
+	// the tree needs the same stack depth as the tokens
+	_, err = evaluateTree(&treeEvaluator{maxDepth: 4}, expr)
+	require.NoError(t, err)
+
+	_, wantErr := evaluate(&rpnEvaluator{maxDepth: 3}, tokens)
+	_, haveErr := evaluateTree(&treeEvaluator{maxDepth: 3}, expr)
+	require.Equal(t, status.Convert(wantErr).Proto(), status.Convert(haveErr).Proto())
+}
+
+func TestExpressionTreeSize(t *testing.T) {
+	doubling := func(step string, n int) string {
+		return strings.TrimSpace(strings.Repeat(step, n))
+	}
+
+	t.Run("tokensWithinLimit", func(t *testing.T) {
+		// dup + doubles the number of nodes each time
+		expr, err := TokensToExpression(mustParseRPN(t, "1 "+doubling(" dup +", 10)))
+		require.NoError(t, err)
+
+		have, err := evaluateTree(&treeEvaluator{}, expr)
+		require.NoError(t, err)
+		require.Equal(t, float64(1<<10), have)
+	})
+
+	testCases := []struct {
+		name string
+		rpn  string
+	}{
+		{name: "dup", rpn: "1 " + doubling(" dup +", 60)},
+		{name: "registers", rpn: "1 sto:a drop " + doubling(" rcl:a rcl:a + sto:a drop", 60) + " rcl:a"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := TokensToExpression(mustParseRPN(t, tc.rpn))
+			eerr, ok := err.(*EvaluationError)
+			require.True(t, ok, "expected *EvaluationError, got %T", err)
+			require.Equal(t, v1pb.OUT_OF_RANGE, eerr.Reason)
+		})
+	}
+
+	t.Run("sharedSubtrees", func(t *testing.T) {
+		expr := &v1pb.Expression{Expression: &v1pb.Expression_Literal{Literal: &v1pb.Operand{Value: 1}}}
+		for i := 0; i < 60; i++ {
+			expr = &v1pb.Expression{Expression: &v1pb.Expression_Binary{Binary: &v1pb.BinaryExpression{Operator: v1pb.ADD, Left: expr, Right: expr}}}
+		}
+
+		wantError := &v1pb.EvaluationError{Reason: v1pb.OUT_OF_RANGE, TokenIndex: -1}
+
+		_, err := evaluateTree(&treeEvaluator{}, expr)
+		require.Contains(t, status.Convert(err).Details(), wantError)
+
+		_, err = expressionTokens(expr)
+		require.Contains(t, status.Convert(err).Details(), wantError)
+	})
+}
@@ -0,0 +1,84 @@
+package calculator
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"google.golang.org/grpc/status"
+)
+
+// maxRowErrors is the maximum number of failed rows described in an EvaluateVector response.
+const maxRowErrors = 100
+
+// cancellationCheckInterval is the number of rows evaluated between checks for cancellation of the call.
+const cancellationCheckInterval = 1024
+
+// evaluateVector evaluates every row of the columns, splitting the rows evenly between one goroutine per CPU.
+func evaluateVector(ctx context.Context, prog *program, columns [][]float64, rows, maxDepth int) (*v1pb.EvaluateVectorResponse, error) {
+	resp := &v1pb.EvaluateVectorResponse{Results: make([]float64, rows)}
+	workers := runtime.GOMAXPROCS(0)
+	chunk := (rows + workers - 1) / workers
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for start := 0; start < rows; start += chunk {
+		end := start + chunk
+		if end > rows {
+			end = rows
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+
+			rpn := &rpnEvaluator{maxDepth: maxDepth}
+			var rowErrs []*v1pb.RowError
+			for row := start; row < end; row++ {
+				if (row-start)%cancellationCheckInterval == 0 && ctx.Err() != nil {
+					return
+				}
+
+				result, index, err := prog.run(rpn, columns, row)
+				if err != nil {
+					resp.Results[row] = math.NaN()
+					// rows are visited in order so only the first few errors can make it to the response
+					if len(rowErrs) < maxRowErrors {
+						var tok *v1pb.Token
+						if index >= 0 {
+							tok = prog.tokens[index]
+						}
+
+						rowErrs = append(rowErrs, &v1pb.RowError{
+							Row:     uint32(row),
+							Message: err.Error(),
+							Error:   evaluationDetail(err, index, tok, rpn.depth()),
+						})
+					}
+					continue
+				}
+
+				resp.Results[row] = result
+			}
+
+			mu.Lock()
+			resp.Errors = append(resp.Errors, rowErrs...)
+			mu.Unlock()
+		}(start, end)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	sort.Slice(resp.Errors, func(i, j int) bool { return resp.Errors[i].Row < resp.Errors[j].Row })
+	if len(resp.Errors) > maxRowErrors {
+		resp.Errors = resp.Errors[:maxRowErrors]
+	}
+
+	return resp, nil
+}
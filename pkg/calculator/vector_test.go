@@ -0,0 +1,127 @@
+package calculator
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEvaluateVector(t *testing.T) {
+	testCases := []struct {
+		name        string
+		tokens      []string
+		columns     map[string][]float64
+		wantResults []float64
+		wantErrRows []uint32
+		wantCode    codes.Code
+	}{
+		{
+			name:        "twoVariables",
+			tokens:      []string{"x", "2", "*", "y", "+"},
+			columns:     map[string][]float64{"x": {1, 2, 3}, "y": {10, 20, 30}},
+			wantResults: []float64{12, 24, 36},
+		},
+		{
+			name:        "repeatedVariable",
+			tokens:      []string{"x", "x", "*"},
+			columns:     map[string][]float64{"x": {-2, 0.5}},
+			wantResults: []float64{4, 0.25},
+		},
+		{
+			name:        "noVariables",
+			tokens:      []string{"1", "2", "+"},
+			columns:     map[string][]float64{"unused": {0, 0}},
+			wantResults: []float64{3, 3},
+		},
+		{
+			name:        "rowErrors",
+			tokens:      []string{"x", "sqrt"},
+			columns:     map[string][]float64{"x": {4, -1, 9, -4}},
+			wantResults: []float64{2, math.NaN(), 3, math.NaN()},
+			wantErrRows: []uint32{1, 3},
+		},
+		{
+			name:     "unbound",
+			tokens:   []string{"x", "y", "+"},
+			columns:  map[string][]float64{"x": {1}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "mismatchedColumns",
+			tokens:   []string{"x", "y", "+"},
+			columns:  map[string][]float64{"x": {1, 2}, "y": {1}},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := parseTokens(tc.tokens)
+			require.NoError(t, err)
+
+			req := &v1pb.EvaluateVectorRequest{Tokens: tokens, Columns: make(map[string]*v1pb.Column)}
+			for name, values := range tc.columns {
+				req.Columns[name] = &v1pb.Column{Values: values}
+			}
+
+			resp, err := NewService().EvaluateVector(context.Background(), req)
+			if tc.wantCode != codes.OK {
+				require.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Results, len(tc.wantResults))
+			for i, want := range tc.wantResults {
+				if math.IsNaN(want) {
+					require.True(t, math.IsNaN(resp.Results[i]), "row %d: expected NaN, got %v", i, resp.Results[i])
+				} else {
+					require.Equal(t, want, resp.Results[i], "row %d", i)
+				}
+			}
+
+			var haveErrRows []uint32
+			for _, rowErr := range resp.Errors {
+				haveErrRows = append(haveErrRows, rowErr.Row)
+				require.Equal(t, v1pb.DOMAIN_ERROR, rowErr.Error.Reason)
+			}
+			require.Equal(t, tc.wantErrRows, haveErrRows)
+		})
+	}
+}
+
+func TestEvaluateVectorLarge(t *testing.T) {
+	const rows = 100000
+
+	x := make([]float64, rows)
+	for i := range x {
+		x[i] = float64(i)
+	}
+
+	prog, err := compile([]*v1pb.Token{
+		{Token: &v1pb.Token_Variable{Variable: &v1pb.Variable{Name: "x"}}},
+		{Token: &v1pb.Token_Operand{Operand: &v1pb.Operand{Value: 1}}},
+		{Token: &v1pb.Token_Operator{Operator: v1pb.ADD}},
+	}, 0)
+	require.NoError(t, err)
+
+	columns, n, err := prog.bind(map[string]*v1pb.Column{"x": {Values: x}})
+	require.NoError(t, err)
+	require.Equal(t, rows, n)
+
+	resp, err := evaluateVector(context.Background(), prog, columns, n, 0)
+	require.NoError(t, err)
+	for i, result := range resp.Results {
+		require.Equal(t, float64(i+1), result)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = evaluateVector(cancelled, prog, columns, n, 0)
+	require.Equal(t, codes.Canceled, status.Code(err))
+}
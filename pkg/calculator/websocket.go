@@ -0,0 +1,206 @@
+package calculator
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charithe/calculator/pkg/v1pb"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// webSocketIdleTimeout is how long a WebSocket connection is kept open without receiving a token.
+	webSocketIdleTimeout  = 5 * time.Minute
+	webSocketWriteTimeout = 10 * time.Second
+	// webSocketEvalTimeout limits the time taken to apply a token and format the resulting state, which can be
+	// considerable for large exact values.
+	webSocketEvalTimeout = 10 * time.Second
+	// maxWebSocketFrameSize limits the size of the token frames, which only need to hold a single token.
+	maxWebSocketFrameSize = 4 << 10
+)
+
+// WebSocketRequest is a frame sent by the client of the WebSocket handler.
+type WebSocketRequest struct {
+	// Token is a single RPN token such as "5", "+", "sqrt" or "sto:a"
+	Token string `json:"token"`
+}
+
+// WebSocketResponse is the frame sent by the WebSocket handler in reply to each WebSocketRequest.
+type WebSocketResponse struct {
+	Token string `json:"token"`
+	// Stack holds the values in the stack formatted as decimal strings, bottom first
+	Stack []string `json:"stack"`
+	// Registers holds the values of the registers formatted as decimal strings
+	Registers map[string]string `json:"registers,omitempty"`
+	// Error is set when the token was rejected, in which case the stack and registers are left unchanged
+	Error *WebSocketError `json:"error,omitempty"`
+}
+
+// WebSocketError describes a rejected token.
+type WebSocketError struct {
+	// Reason is the name of a v1pb.ErrorReason value
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// NewWebSocketHandler serves an interactive evaluation over WebSocket for browser clients. Each connection has its
+// own stack and registers, to which the client applies tokens one at a time by sending WebSocketRequest frames as
+// JSON. Every frame is answered with a WebSocketResponse describing the state after the token.
+// The precision, float_precision and max_stack_depth query parameters configure the evaluation like the fields of
+// EvaluateBatchRequest. Connections from browsers are only accepted if the page has the same origin as the handler or
+// allowOrigin returns true for its origin. allowOrigin may be nil. The connection is closed if a token takes longer
+// than webSocketEvalTimeout to evaluate.
+func NewWebSocketHandler(svc *Service, allowOrigin func(origin string) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rpn, err := svc.webSocketEvaluator(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		srv := websocket.Server{
+			Handshake: func(config *websocket.Config, r *http.Request) error {
+				origin, err := websocket.Origin(config, r)
+				if err != nil {
+					return err
+				}
+				return checkWebSocketOrigin(origin, r, allowOrigin)
+			},
+			Handler: func(ws *websocket.Conn) {
+				defer ws.Close()
+				serveWebSocket(ws, rpn, webSocketEvalTimeout)
+			},
+		}
+		srv.ServeHTTP(w, r)
+	})
+}
+
+func (s *Service) webSocketEvaluator(query url.Values) (*rpnEvaluator, error) {
+	precision := v1pb.FLOAT64
+	if p := query.Get("precision"); p != "" {
+		v, ok := v1pb.Precision_value[strings.ToUpper(p)]
+		if !ok {
+			return nil, fmt.Errorf("unknown precision: %s", p)
+		}
+		precision = v1pb.Precision(v)
+	}
+
+	var floatPrecision, maxDepth uint64
+	var err error
+	if fp := query.Get("float_precision"); fp != "" {
+		if floatPrecision, err = strconv.ParseUint(fp, 10, 32); err != nil {
+			return nil, fmt.Errorf("invalid float_precision: %s", fp)
+		}
+	}
+
+	if d := query.Get("max_stack_depth"); d != "" {
+		if maxDepth, err = strconv.ParseUint(d, 10, 32); err != nil {
+			return nil, fmt.Errorf("invalid max_stack_depth: %s", d)
+		}
+	}
+
+	arith, err := newArithmetic(precision, uint32(floatPrecision))
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpnEvaluator{arith: arith, maxDepth: s.stackDepth(uint32(maxDepth))}, nil
+}
+
+// checkWebSocketOrigin accepts clients that do not send an origin, which are not browsers, and browsers on pages with
+// the same origin as the request or an origin accepted by allowOrigin.
+func checkWebSocketOrigin(origin *url.URL, r *http.Request, allowOrigin func(string) bool) error {
+	if origin == nil || strings.EqualFold(origin.Host, r.Host) {
+		return nil
+	}
+
+	if allowOrigin != nil && allowOrigin(origin.String()) {
+		return nil
+	}
+
+	return websocket.ErrBadWebSocketOrigin
+}
+
+func serveWebSocket(ws *websocket.Conn, rpn *rpnEvaluator, evalTimeout time.Duration) {
+	ws.MaxPayloadBytes = maxWebSocketFrameSize
+
+	for {
+		// the deadlines of the HTTP server still apply to the connection so they must be replaced
+		ws.SetReadDeadline(time.Now().Add(webSocketIdleTimeout))
+
+		var req WebSocketRequest
+		resp := &WebSocketResponse{}
+		if err := websocket.JSON.Receive(ws, &req); err != nil {
+			if err == io.EOF {
+				return
+			}
+
+			if _, ok := err.(net.Error); ok {
+				zap.S().Debugw("Closing WebSocket connection", "error", err)
+				return
+			}
+
+			// oversized and malformed frames are skipped so the client can carry on
+			resp.Error = &WebSocketError{Reason: v1pb.SYNTAX_ERROR.String(), Message: "invalid frame: " + err.Error()}
+		} else {
+			resp.Token = req.Token
+		}
+
+		if !evaluateFrame(rpn, resp, req.Token, evalTimeout) {
+			zap.S().Infow("Closing WebSocket connection after evaluation timeout", "token", req.Token)
+			return
+		}
+
+		ws.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+		if err := websocket.JSON.Send(ws, resp); err != nil {
+			zap.S().Debugw("Failed to send WebSocket response", "error", err)
+			return
+		}
+	}
+}
+
+// evaluateFrame applies the token unless the frame was rejected already and fills in the state of the evaluator. It
+// returns false if that does not finish within the timeout, in which case the evaluation carries on in the background
+// and neither rpn nor resp may be used again.
+func evaluateFrame(rpn *rpnEvaluator, resp *WebSocketResponse, tokenStr string, timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if resp.Error == nil {
+			resp.Error = applyTokenString(rpn, tokenStr)
+		}
+		resp.Stack = rpn.formatStack()
+		resp.Registers = rpn.formatRegisters()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// applyTokenString parses and applies the token. The state of the evaluator is unchanged if the token is rejected.
+func applyTokenString(rpn *rpnEvaluator, tokenStr string) *WebSocketError {
+	tok, err := parseToken(tokenStr)
+	if err != nil {
+		return &WebSocketError{Reason: v1pb.SYNTAX_ERROR.String(), Message: err.Error()}
+	}
+
+	if err := applyToken(rpn, tok); err != nil {
+		return &WebSocketError{Reason: errorReason(err).String(), Message: errorMessage(err, rpn.maxDepth)}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package calculator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func TestWebSocket(t *testing.T) {
+	allowOrigin := func(origin string) bool { return origin == "https://repl.example.com" }
+	srv := httptest.NewServer(NewWebSocketHandler(NewService(WithMaxStackDepth(3)), allowOrigin))
+	defer srv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	t.Run("tokens", func(t *testing.T) {
+		ws, err := websocket.Dial(wsURL, "", srv.URL)
+		require.NoError(t, err)
+		defer ws.Close()
+
+		testCases := []struct {
+			token     string
+			wantStack []string
+			wantError string
+		}{
+			{token: "5", wantStack: []string{"5"}},
+			{token: "sto:a", wantStack: []string{"5"}},
+			{token: "8", wantStack: []string{"5", "8"}},
+			{token: "+", wantStack: []string{"13"}},
+			{token: "*", wantStack: []string{"13"}, wantError: "NOT_ENOUGH_OPERANDS"},
+			{token: "rcl:b", wantStack: []string{"13"}, wantError: "EMPTY_REGISTER"},
+			{token: "foo(", wantStack: []string{"13"}, wantError: "SYNTAX_ERROR"},
+			{token: "dup", wantStack: []string{"13", "13"}},
+			{token: "dup", wantStack: []string{"13", "13", "13"}},
+			{token: "dup", wantStack: []string{"13", "13", "13"}, wantError: "STACK_FULL"},
+			{token: "clear", wantStack: []string{}},
+		}
+
+		for i, tc := range testCases {
+			require.NoError(t, websocket.JSON.Send(ws, WebSocketRequest{Token: tc.token}))
+
+			var resp WebSocketResponse
+			require.NoError(t, websocket.JSON.Receive(ws, &resp))
+			require.Equal(t, tc.token, resp.Token)
+			require.Equal(t, tc.wantStack, resp.Stack, tc.token)
+			// the register is set by the second token
+			if i > 0 {
+				require.Equal(t, map[string]string{"a": "5"}, resp.Registers)
+			}
+
+			if tc.wantError == "" {
+				require.Nil(t, resp.Error, tc.token)
+			} else {
+				require.NotNil(t, resp.Error, tc.token)
+				require.Equal(t, tc.wantError, resp.Error.Reason, tc.token)
+			}
+		}
+
+		// malformed frames are answered with an error without closing the connection
+		_, err = ws.Write([]byte("{"))
+		require.NoError(t, err)
+
+		var resp WebSocketResponse
+		require.NoError(t, websocket.JSON.Receive(ws, &resp))
+		require.Equal(t, "SYNTAX_ERROR", resp.Error.Reason)
+
+		require.NoError(t, websocket.JSON.Send(ws, WebSocketRequest{Token: "1"}))
+		require.NoError(t, websocket.JSON.Receive(ws, &resp))
+		require.Equal(t, []string{"1"}, resp.Stack)
+	})
+
+	t.Run("precision", func(t *testing.T) {
+		ws, err := websocket.Dial(wsURL+"?precision=exact", "", srv.URL)
+		require.NoError(t, err)
+		defer ws.Close()
+
+		var resp WebSocketResponse
+		for _, tok := range []string{"0.1", "0.2", "+"} {
+			require.NoError(t, websocket.JSON.Send(ws, WebSocketRequest{Token: tok}))
+			require.NoError(t, websocket.JSON.Receive(ws, &resp))
+		}
+		require.Equal(t, []string{"0.3"}, resp.Stack)
+	})
+
+	t.Run("invalidQuery", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "?precision=decimal")
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("origins", func(t *testing.T) {
+		testCases := []struct {
+			origin  string
+			wantErr bool
+		}{
+			{origin: srv.URL},
+			{origin: "https://repl.example.com"},
+			{origin: "https://elsewhere.example.com", wantErr: true},
+		}
+
+		for _, tc := range testCases {
+			ws, err := websocket.Dial(wsURL, "", tc.origin)
+			if tc.wantErr {
+				require.Error(t, err, tc.origin)
+				continue
+			}
+
+			require.NoError(t, err, tc.origin)
+			ws.Close()
+		}
+	})
+}
+
+func TestWebSocketEvalTimeout(t *testing.T) {
+	rpn := &rpnEvaluator{arith: ratArithmetic{}, maxDepth: 1000}
+	for _, tok := range []string{"3", "20000", "^"} {
+		require.Nil(t, applyTokenString(rpn, tok))
+	}
+
+	for i := 0; i < 200; i++ {
+		require.Nil(t, applyTokenString(rpn, "dup"))
+	}
+
+	resp := &WebSocketResponse{Token: "dup"}
+	require.True(t, evaluateFrame(rpn, resp, "dup", time.Minute))
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Stack, 202)
+
+	// formatting the stack of large exact values alone takes far longer than the timeout
+	require.False(t, evaluateFrame(rpn, &WebSocketResponse{Token: "dup"}, "dup", time.Nanosecond))
+}
@@ -8,6 +8,7 @@ import (
 	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	grpc "google.golang.org/grpc"
 	io "io"
 	math "math"
@@ -35,6 +36,9 @@ const (
 	SUBTRACT  Operator = 2
 	MULTIPLY  Operator = 3
 	DIVIDE    Operator = 4
+	POW       Operator = 5
+	MOD       Operator = 6
+	IDIV      Operator = 7
 )
 
 var Operator_name = map[int32]string{
@@ -43,6 +47,9 @@ var Operator_name = map[int32]string{
 	2: "SUBTRACT",
 	3: "MULTIPLY",
 	4: "DIVIDE",
+	5: "POW",
+	6: "MOD",
+	7: "IDIV",
 }
 
 var Operator_value = map[string]int32{
@@ -51,14 +58,177 @@ var Operator_value = map[string]int32{
 	"SUBTRACT":  2,
 	"MULTIPLY":  3,
 	"DIVIDE":    4,
+	"POW":       5,
+	"MOD":       6,
+	"IDIV":      7,
 }
 
 func (Operator) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ce4015ff54a8a5a4, []int{0}
 }
 
+type Notation int32
+
+const (
+	RPN   Notation = 0
+	INFIX Notation = 1
+)
+
+var Notation_name = map[int32]string{
+	0: "RPN",
+	1: "INFIX",
+}
+
+var Notation_value = map[string]int32{
+	"RPN":   0,
+	"INFIX": 1,
+}
+
+func (Notation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{1}
+}
+
+type StackOp int32
+
+const (
+	STACK_OP_UNDEFINED StackOp = 0
+	DUP                StackOp = 1
+	SWAP               StackOp = 2
+	DROP               StackOp = 3
+	ROT                StackOp = 4
+	CLEAR              StackOp = 5
+)
+
+var StackOp_name = map[int32]string{
+	0: "STACK_OP_UNDEFINED",
+	1: "DUP",
+	2: "SWAP",
+	3: "DROP",
+	4: "ROT",
+	5: "CLEAR",
+}
+
+var StackOp_value = map[string]int32{
+	"STACK_OP_UNDEFINED": 0,
+	"DUP":                1,
+	"SWAP":               2,
+	"DROP":               3,
+	"ROT":                4,
+	"CLEAR":              5,
+}
+
+func (StackOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{2}
+}
+
+type RegisterOp int32
+
+const (
+	REGISTER_OP_UNDEFINED RegisterOp = 0
+	STO                   RegisterOp = 1
+	RCL                   RegisterOp = 2
+)
+
+var RegisterOp_name = map[int32]string{
+	0: "REGISTER_OP_UNDEFINED",
+	1: "STO",
+	2: "RCL",
+}
+
+var RegisterOp_value = map[string]int32{
+	"REGISTER_OP_UNDEFINED": 0,
+	"STO":                   1,
+	"RCL":                   2,
+}
+
+func (RegisterOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{3}
+}
+
+type Precision int32
+
+const (
+	FLOAT64   Precision = 0
+	BIG_FLOAT Precision = 1
+	EXACT     Precision = 2
+)
+
+var Precision_name = map[int32]string{
+	0: "FLOAT64",
+	1: "BIG_FLOAT",
+	2: "EXACT",
+}
+
+var Precision_value = map[string]int32{
+	"FLOAT64":   0,
+	"BIG_FLOAT": 1,
+	"EXACT":     2,
+}
+
+func (Precision) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{4}
+}
+
+type ErrorReason int32
+
+const (
+	ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	NOT_ENOUGH_OPERANDS      ErrorReason = 1
+	STACK_FULL               ErrorReason = 2
+	INCOMPLETE_EXPRESSION    ErrorReason = 3
+	DIVISION_BY_ZERO         ErrorReason = 4
+	DOMAIN_ERROR             ErrorReason = 5
+	OUT_OF_RANGE             ErrorReason = 6
+	UNKNOWN_OPERATION        ErrorReason = 7
+	WRONG_ARITY              ErrorReason = 8
+	INVALID_OPERAND          ErrorReason = 9
+	UNSUPPORTED_OPERATION    ErrorReason = 10
+	UNBOUND_VARIABLE         ErrorReason = 11
+	SYNTAX_ERROR             ErrorReason = 12
+	EMPTY_REGISTER           ErrorReason = 13
+)
+
+var ErrorReason_name = map[int32]string{
+	0:  "ERROR_REASON_UNSPECIFIED",
+	1:  "NOT_ENOUGH_OPERANDS",
+	2:  "STACK_FULL",
+	3:  "INCOMPLETE_EXPRESSION",
+	4:  "DIVISION_BY_ZERO",
+	5:  "DOMAIN_ERROR",
+	6:  "OUT_OF_RANGE",
+	7:  "UNKNOWN_OPERATION",
+	8:  "WRONG_ARITY",
+	9:  "INVALID_OPERAND",
+	10: "UNSUPPORTED_OPERATION",
+	11: "UNBOUND_VARIABLE",
+	12: "SYNTAX_ERROR",
+	13: "EMPTY_REGISTER",
+}
+
+var ErrorReason_value = map[string]int32{
+	"ERROR_REASON_UNSPECIFIED": 0,
+	"NOT_ENOUGH_OPERANDS":      1,
+	"STACK_FULL":               2,
+	"INCOMPLETE_EXPRESSION":    3,
+	"DIVISION_BY_ZERO":         4,
+	"DOMAIN_ERROR":             5,
+	"OUT_OF_RANGE":             6,
+	"UNKNOWN_OPERATION":        7,
+	"WRONG_ARITY":              8,
+	"INVALID_OPERAND":          9,
+	"UNSUPPORTED_OPERATION":    10,
+	"UNBOUND_VARIABLE":         11,
+	"SYNTAX_ERROR":             12,
+	"EMPTY_REGISTER":           13,
+}
+
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{5}
+}
+
 type Operand struct {
-	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Value   float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Decimal string  `protobuf:"bytes,2,opt,name=decimal,proto3" json:"decimal,omitempty"`
 }
 
 func (m *Operand) Reset()      { *m = Operand{} }
@@ -100,17 +270,173 @@ func (m *Operand) GetValue() float64 {
 	return 0
 }
 
+func (m *Operand) GetDecimal() string {
+	if m != nil {
+		return m.Decimal
+	}
+	return ""
+}
+
+type Function struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Arity int32  `protobuf:"varint,2,opt,name=arity,proto3" json:"arity,omitempty"`
+}
+
+func (m *Function) Reset()      { *m = Function{} }
+func (*Function) ProtoMessage() {}
+func (*Function) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{1}
+}
+func (m *Function) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Function) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Function.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Function) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Function.Merge(m, src)
+}
+func (m *Function) XXX_Size() int {
+	return m.Size()
+}
+func (m *Function) XXX_DiscardUnknown() {
+	xxx_messageInfo_Function.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Function proto.InternalMessageInfo
+
+func (m *Function) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Function) GetArity() int32 {
+	if m != nil {
+		return m.Arity
+	}
+	return 0
+}
+
+type Variable struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *Variable) Reset()      { *m = Variable{} }
+func (*Variable) ProtoMessage() {}
+func (*Variable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{2}
+}
+func (m *Variable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Variable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Variable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Variable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Variable.Merge(m, src)
+}
+func (m *Variable) XXX_Size() int {
+	return m.Size()
+}
+func (m *Variable) XXX_DiscardUnknown() {
+	xxx_messageInfo_Variable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Variable proto.InternalMessageInfo
+
+func (m *Variable) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type Register struct {
+	Op   RegisterOp `protobuf:"varint,1,opt,name=op,proto3,enum=com.github.charithe.calculator.v1.RegisterOp" json:"op,omitempty"`
+	Name string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *Register) Reset()      { *m = Register{} }
+func (*Register) ProtoMessage() {}
+func (*Register) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{3}
+}
+func (m *Register) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Register) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Register.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Register) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Register.Merge(m, src)
+}
+func (m *Register) XXX_Size() int {
+	return m.Size()
+}
+func (m *Register) XXX_DiscardUnknown() {
+	xxx_messageInfo_Register.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Register proto.InternalMessageInfo
+
+func (m *Register) GetOp() RegisterOp {
+	if m != nil {
+		return m.Op
+	}
+	return REGISTER_OP_UNDEFINED
+}
+
+func (m *Register) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type Token struct {
 	// Types that are valid to be assigned to Token:
 	//	*Token_Operand
 	//	*Token_Operator
+	//	*Token_Function
+	//	*Token_StackOp
+	//	*Token_Variable
+	//	*Token_Register
 	Token isToken_Token `protobuf_oneof:"token"`
 }
 
 func (m *Token) Reset()      { *m = Token{} }
 func (*Token) ProtoMessage() {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce4015ff54a8a5a4, []int{1}
+	return fileDescriptor_ce4015ff54a8a5a4, []int{4}
 }
 func (m *Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,9 +478,25 @@ type Token_Operand struct {
 type Token_Operator struct {
 	Operator Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=com.github.charithe.calculator.v1.Operator,oneof"`
 }
+type Token_Function struct {
+	Function *Function `protobuf:"bytes,3,opt,name=function,proto3,oneof"`
+}
+type Token_StackOp struct {
+	StackOp StackOp `protobuf:"varint,4,opt,name=stack_op,json=stackOp,proto3,enum=com.github.charithe.calculator.v1.StackOp,oneof"`
+}
+type Token_Variable struct {
+	Variable *Variable `protobuf:"bytes,5,opt,name=variable,proto3,oneof"`
+}
+type Token_Register struct {
+	Register *Register `protobuf:"bytes,6,opt,name=register,proto3,oneof"`
+}
 
 func (*Token_Operand) isToken_Token()  {}
 func (*Token_Operator) isToken_Token() {}
+func (*Token_Function) isToken_Token() {}
+func (*Token_StackOp) isToken_Token()  {}
+func (*Token_Variable) isToken_Token() {}
+func (*Token_Register) isToken_Token() {}
 
 func (m *Token) GetToken() isToken_Token {
 	if m != nil {
@@ -177,11 +519,43 @@ func (m *Token) GetOperator() Operator {
 	return UNDEFINED
 }
 
+func (m *Token) GetFunction() *Function {
+	if x, ok := m.GetToken().(*Token_Function); ok {
+		return x.Function
+	}
+	return nil
+}
+
+func (m *Token) GetStackOp() StackOp {
+	if x, ok := m.GetToken().(*Token_StackOp); ok {
+		return x.StackOp
+	}
+	return STACK_OP_UNDEFINED
+}
+
+func (m *Token) GetVariable() *Variable {
+	if x, ok := m.GetToken().(*Token_Variable); ok {
+		return x.Variable
+	}
+	return nil
+}
+
+func (m *Token) GetRegister() *Register {
+	if x, ok := m.GetToken().(*Token_Register); ok {
+		return x.Register
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Token) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Token_OneofMarshaler, _Token_OneofUnmarshaler, _Token_OneofSizer, []interface{}{
 		(*Token_Operand)(nil),
 		(*Token_Operator)(nil),
+		(*Token_Function)(nil),
+		(*Token_StackOp)(nil),
+		(*Token_Variable)(nil),
+		(*Token_Register)(nil),
 	}
 }
 
@@ -197,6 +571,24 @@ func _Token_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 	case *Token_Operator:
 		_ = b.EncodeVarint(2<<3 | proto.WireVarint)
 		_ = b.EncodeVarint(uint64(x.Operator))
+	case *Token_Function:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Function); err != nil {
+			return err
+		}
+	case *Token_StackOp:
+		_ = b.EncodeVarint(4<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(uint64(x.StackOp))
+	case *Token_Variable:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Variable); err != nil {
+			return err
+		}
+	case *Token_Register:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Register); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Token.Token has unexpected type %T", x)
@@ -222,6 +614,37 @@ func _Token_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		x, err := b.DecodeVarint()
 		m.Token = &Token_Operator{Operator(x)}
 		return true, err
+	case 3: // token.function
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Function)
+		err := b.DecodeMessage(msg)
+		m.Token = &Token_Function{msg}
+		return true, err
+	case 4: // token.stack_op
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Token = &Token_StackOp{StackOp(x)}
+		return true, err
+	case 5: // token.variable
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Variable)
+		err := b.DecodeMessage(msg)
+		m.Token = &Token_Variable{msg}
+		return true, err
+	case 6: // token.register
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Register)
+		err := b.DecodeMessage(msg)
+		m.Token = &Token_Register{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -239,6 +662,24 @@ func _Token_OneofSizer(msg proto.Message) (n int) {
 	case *Token_Operator:
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(x.Operator))
+	case *Token_Function:
+		s := proto.Size(x.Function)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Token_StackOp:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.StackOp))
+	case *Token_Variable:
+		s := proto.Size(x.Variable)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Token_Register:
+		s := proto.Size(x.Register)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -246,21 +687,26 @@ func _Token_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-type EvaluateStreamRequest struct {
-	Token *Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+type Expression struct {
+	// Types that are valid to be assigned to Expression:
+	//	*Expression_Literal
+	//	*Expression_Variable
+	//	*Expression_Binary
+	//	*Expression_Call
+	Expression isExpression_Expression `protobuf_oneof:"expression"`
 }
 
-func (m *EvaluateStreamRequest) Reset()      { *m = EvaluateStreamRequest{} }
-func (*EvaluateStreamRequest) ProtoMessage() {}
-func (*EvaluateStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce4015ff54a8a5a4, []int{2}
+func (m *Expression) Reset()      { *m = Expression{} }
+func (*Expression) ProtoMessage() {}
+func (*Expression) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{5}
 }
-func (m *EvaluateStreamRequest) XXX_Unmarshal(b []byte) error {
+func (m *Expression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EvaluateStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Expression) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EvaluateStreamRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Expression.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -270,83 +716,207 @@ func (m *EvaluateStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EvaluateStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvaluateStreamRequest.Merge(m, src)
+func (m *Expression) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Expression.Merge(m, src)
 }
-func (m *EvaluateStreamRequest) XXX_Size() int {
+func (m *Expression) XXX_Size() int {
 	return m.Size()
 }
-func (m *EvaluateStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EvaluateStreamRequest.DiscardUnknown(m)
+func (m *Expression) XXX_DiscardUnknown() {
+	xxx_messageInfo_Expression.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EvaluateStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_Expression proto.InternalMessageInfo
 
-func (m *EvaluateStreamRequest) GetToken() *Token {
+type isExpression_Expression interface {
+	isExpression_Expression()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Expression_Literal struct {
+	Literal *Operand `protobuf:"bytes,1,opt,name=literal,proto3,oneof"`
+}
+type Expression_Variable struct {
+	Variable *Variable `protobuf:"bytes,2,opt,name=variable,proto3,oneof"`
+}
+type Expression_Binary struct {
+	Binary *BinaryExpression `protobuf:"bytes,3,opt,name=binary,proto3,oneof"`
+}
+type Expression_Call struct {
+	Call *Call `protobuf:"bytes,4,opt,name=call,proto3,oneof"`
+}
+
+func (*Expression_Literal) isExpression_Expression()  {}
+func (*Expression_Variable) isExpression_Expression() {}
+func (*Expression_Binary) isExpression_Expression()   {}
+func (*Expression_Call) isExpression_Expression()     {}
+
+func (m *Expression) GetExpression() isExpression_Expression {
 	if m != nil {
-		return m.Token
+		return m.Expression
 	}
 	return nil
 }
 
-type EvaluateStreamResponse struct {
-	Result float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *Expression) GetLiteral() *Operand {
+	if x, ok := m.GetExpression().(*Expression_Literal); ok {
+		return x.Literal
+	}
+	return nil
 }
 
-func (m *EvaluateStreamResponse) Reset()      { *m = EvaluateStreamResponse{} }
-func (*EvaluateStreamResponse) ProtoMessage() {}
-func (*EvaluateStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce4015ff54a8a5a4, []int{3}
-}
-func (m *EvaluateStreamResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Expression) GetVariable() *Variable {
+	if x, ok := m.GetExpression().(*Expression_Variable); ok {
+		return x.Variable
+	}
+	return nil
 }
-func (m *EvaluateStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EvaluateStreamResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Expression) GetBinary() *BinaryExpression {
+	if x, ok := m.GetExpression().(*Expression_Binary); ok {
+		return x.Binary
 	}
+	return nil
 }
-func (m *EvaluateStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvaluateStreamResponse.Merge(m, src)
+
+func (m *Expression) GetCall() *Call {
+	if x, ok := m.GetExpression().(*Expression_Call); ok {
+		return x.Call
+	}
+	return nil
 }
-func (m *EvaluateStreamResponse) XXX_Size() int {
-	return m.Size()
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Expression) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Expression_OneofMarshaler, _Expression_OneofUnmarshaler, _Expression_OneofSizer, []interface{}{
+		(*Expression_Literal)(nil),
+		(*Expression_Variable)(nil),
+		(*Expression_Binary)(nil),
+		(*Expression_Call)(nil),
+	}
 }
-func (m *EvaluateStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EvaluateStreamResponse.DiscardUnknown(m)
+
+func _Expression_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Expression)
+	// expression
+	switch x := m.Expression.(type) {
+	case *Expression_Literal:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Literal); err != nil {
+			return err
+		}
+	case *Expression_Variable:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Variable); err != nil {
+			return err
+		}
+	case *Expression_Binary:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Binary); err != nil {
+			return err
+		}
+	case *Expression_Call:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Call); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Expression.Expression has unexpected type %T", x)
+	}
+	return nil
 }
 
-var xxx_messageInfo_EvaluateStreamResponse proto.InternalMessageInfo
+func _Expression_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Expression)
+	switch tag {
+	case 1: // expression.literal
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Operand)
+		err := b.DecodeMessage(msg)
+		m.Expression = &Expression_Literal{msg}
+		return true, err
+	case 2: // expression.variable
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Variable)
+		err := b.DecodeMessage(msg)
+		m.Expression = &Expression_Variable{msg}
+		return true, err
+	case 3: // expression.binary
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BinaryExpression)
+		err := b.DecodeMessage(msg)
+		m.Expression = &Expression_Binary{msg}
+		return true, err
+	case 4: // expression.call
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Call)
+		err := b.DecodeMessage(msg)
+		m.Expression = &Expression_Call{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
 
-func (m *EvaluateStreamResponse) GetResult() float64 {
-	if m != nil {
-		return m.Result
+func _Expression_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Expression)
+	// expression
+	switch x := m.Expression.(type) {
+	case *Expression_Literal:
+		s := proto.Size(x.Literal)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Expression_Variable:
+		s := proto.Size(x.Variable)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Expression_Binary:
+		s := proto.Size(x.Binary)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Expression_Call:
+		s := proto.Size(x.Call)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
-	return 0
+	return n
 }
 
-type EvaluateBatchRequest struct {
-	Tokens []*Token `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+type BinaryExpression struct {
+	Operator Operator    `protobuf:"varint,1,opt,name=operator,proto3,enum=com.github.charithe.calculator.v1.Operator" json:"operator,omitempty"`
+	Left     *Expression `protobuf:"bytes,2,opt,name=left,proto3" json:"left,omitempty"`
+	Right    *Expression `protobuf:"bytes,3,opt,name=right,proto3" json:"right,omitempty"`
 }
 
-func (m *EvaluateBatchRequest) Reset()      { *m = EvaluateBatchRequest{} }
-func (*EvaluateBatchRequest) ProtoMessage() {}
-func (*EvaluateBatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce4015ff54a8a5a4, []int{4}
+func (m *BinaryExpression) Reset()      { *m = BinaryExpression{} }
+func (*BinaryExpression) ProtoMessage() {}
+func (*BinaryExpression) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{6}
 }
-func (m *EvaluateBatchRequest) XXX_Unmarshal(b []byte) error {
+func (m *BinaryExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EvaluateBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BinaryExpression) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EvaluateBatchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BinaryExpression.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -356,40 +926,55 @@ func (m *EvaluateBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EvaluateBatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvaluateBatchRequest.Merge(m, src)
+func (m *BinaryExpression) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinaryExpression.Merge(m, src)
 }
-func (m *EvaluateBatchRequest) XXX_Size() int {
+func (m *BinaryExpression) XXX_Size() int {
 	return m.Size()
 }
-func (m *EvaluateBatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EvaluateBatchRequest.DiscardUnknown(m)
+func (m *BinaryExpression) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinaryExpression.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EvaluateBatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_BinaryExpression proto.InternalMessageInfo
 
-func (m *EvaluateBatchRequest) GetTokens() []*Token {
+func (m *BinaryExpression) GetOperator() Operator {
 	if m != nil {
-		return m.Tokens
+		return m.Operator
+	}
+	return UNDEFINED
+}
+
+func (m *BinaryExpression) GetLeft() *Expression {
+	if m != nil {
+		return m.Left
 	}
 	return nil
 }
 
-type EvaluateBatchResponse struct {
-	Result float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *BinaryExpression) GetRight() *Expression {
+	if m != nil {
+		return m.Right
+	}
+	return nil
 }
 
-func (m *EvaluateBatchResponse) Reset()      { *m = EvaluateBatchResponse{} }
-func (*EvaluateBatchResponse) ProtoMessage() {}
-func (*EvaluateBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce4015ff54a8a5a4, []int{5}
+type Call struct {
+	Name string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args []*Expression `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 }
-func (m *EvaluateBatchResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *Call) Reset()      { *m = Call{} }
+func (*Call) ProtoMessage() {}
+func (*Call) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{7}
+}
+func (m *Call) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EvaluateBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Call) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EvaluateBatchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Call.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -399,904 +984,14681 @@ func (m *EvaluateBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EvaluateBatchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvaluateBatchResponse.Merge(m, src)
+func (m *Call) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Call.Merge(m, src)
 }
-func (m *EvaluateBatchResponse) XXX_Size() int {
+func (m *Call) XXX_Size() int {
 	return m.Size()
 }
-func (m *EvaluateBatchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EvaluateBatchResponse.DiscardUnknown(m)
+func (m *Call) XXX_DiscardUnknown() {
+	xxx_messageInfo_Call.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EvaluateBatchResponse proto.InternalMessageInfo
+var xxx_messageInfo_Call proto.InternalMessageInfo
 
-func (m *EvaluateBatchResponse) GetResult() float64 {
+func (m *Call) GetName() string {
 	if m != nil {
-		return m.Result
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("com.github.charithe.calculator.v1.Operator", Operator_name, Operator_value)
-	proto.RegisterType((*Operand)(nil), "com.github.charithe.calculator.v1.Operand")
-	proto.RegisterType((*Token)(nil), "com.github.charithe.calculator.v1.Token")
-	proto.RegisterType((*EvaluateStreamRequest)(nil), "com.github.charithe.calculator.v1.EvaluateStreamRequest")
-	proto.RegisterType((*EvaluateStreamResponse)(nil), "com.github.charithe.calculator.v1.EvaluateStreamResponse")
-	proto.RegisterType((*EvaluateBatchRequest)(nil), "com.github.charithe.calculator.v1.EvaluateBatchRequest")
-	proto.RegisterType((*EvaluateBatchResponse)(nil), "com.github.charithe.calculator.v1.EvaluateBatchResponse")
+func (m *Call) GetArgs() []*Expression {
+	if m != nil {
+		return m.Args
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("pkg/v1pb/calculator.proto", fileDescriptor_ce4015ff54a8a5a4) }
-
-var fileDescriptor_ce4015ff54a8a5a4 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xef, 0x25, 0xcd, 0x0f, 0x1e, 0xb4, 0xb2, 0x4e, 0xa5, 0x0a, 0x1d, 0x8e, 0xe0, 0x29,
-	0x2a, 0x92, 0x43, 0xc3, 0x40, 0x61, 0x40, 0x34, 0x75, 0xaa, 0x1a, 0x95, 0x82, 0xdc, 0x84, 0x5f,
-	0xdb, 0xc5, 0x9c, 0x9a, 0xaa, 0x69, 0xce, 0x9c, 0xcf, 0x99, 0x11, 0x0b, 0x2b, 0x3b, 0x23, 0x0b,
-	0x7f, 0x0a, 0x63, 0xc6, 0x8e, 0xc4, 0x59, 0x18, 0xfb, 0x27, 0xa0, 0x38, 0x97, 0xd2, 0x20, 0x04,
-	0x49, 0x37, 0x3f, 0xe9, 0x7d, 0x3f, 0xfe, 0xbc, 0x77, 0x7a, 0x78, 0x2b, 0x3c, 0x39, 0xaa, 0xf6,
-	0x37, 0xc3, 0x76, 0x35, 0xe0, 0xdd, 0x20, 0xee, 0x72, 0x2d, 0x95, 0x13, 0x2a, 0xa9, 0x25, 0xbd,
-	0x13, 0xc8, 0x53, 0xe7, 0xe8, 0x58, 0x77, 0xe2, 0xb6, 0x13, 0x74, 0xb8, 0x3a, 0xd6, 0x1d, 0xe1,
-	0x5c, 0xea, 0xea, 0x6f, 0xda, 0xb7, 0xb1, 0xf0, 0x3c, 0x14, 0x8a, 0xf7, 0xde, 0xd1, 0x55, 0xcc,
-	0xf5, 0x79, 0x37, 0x16, 0x25, 0x28, 0x43, 0x05, 0xfc, 0x49, 0x61, 0x7f, 0x05, 0xcc, 0x35, 0xe5,
-	0x89, 0xe8, 0xd1, 0x5d, 0x2c, 0xc8, 0x49, 0x6b, 0xda, 0x71, 0xbd, 0xb6, 0xe1, 0xfc, 0x97, 0xef,
-	0x18, 0xf8, 0x1e, 0xf1, 0xa7, 0x61, 0xea, 0x61, 0x31, 0xfd, 0xd4, 0x52, 0x95, 0x32, 0x65, 0xa8,
-	0xac, 0xd4, 0xee, 0xce, 0x0b, 0xd2, 0x52, 0xed, 0x11, 0xff, 0x22, 0x5e, 0x2f, 0x60, 0x4e, 0x8f,
-	0xdd, 0xec, 0x57, 0x78, 0xb3, 0x31, 0xf6, 0xe5, 0x5a, 0x1c, 0x6a, 0x25, 0xf8, 0xa9, 0x2f, 0xde,
-	0xc7, 0x22, 0xd2, 0xf4, 0xb1, 0xe9, 0x30, 0xca, 0x95, 0x39, 0xfe, 0x94, 0x4e, 0xeb, 0x1b, 0xf0,
-	0x3d, 0x5c, 0xfb, 0x13, 0x1c, 0x85, 0xb2, 0x17, 0x09, 0xba, 0x86, 0x79, 0x25, 0xa2, 0xb8, 0xab,
-	0xcd, 0xbe, 0x4c, 0x65, 0xbf, 0xc6, 0xd5, 0x69, 0xa2, 0xce, 0x75, 0xd0, 0x99, 0x9a, 0x3c, 0xc1,
-	0x7c, 0x8a, 0x8c, 0x4a, 0x50, 0xce, 0x2e, 0xa4, 0x62, 0x72, 0x76, 0xf5, 0xf7, 0x90, 0x86, 0xfc,
-	0x6f, 0x95, 0x8d, 0xa7, 0x58, 0x9c, 0xae, 0x8d, 0x2e, 0xe3, 0xb5, 0xd6, 0x81, 0xdb, 0xd8, 0xf5,
-	0x0e, 0x1a, 0xae, 0x45, 0x68, 0x01, 0xb3, 0xdb, 0xae, 0x6b, 0x01, 0xbd, 0x81, 0xc5, 0xc3, 0x56,
-	0xbd, 0xe9, 0x6f, 0xef, 0x34, 0xad, 0xcc, 0xb8, 0x7a, 0xd6, 0xda, 0x6f, 0x7a, 0x2f, 0xf6, 0xdf,
-	0x58, 0x59, 0x8a, 0x98, 0x77, 0xbd, 0x97, 0x9e, 0xdb, 0xb0, 0x96, 0x6a, 0x5f, 0x32, 0x88, 0x3b,
-	0x17, 0x72, 0xf4, 0x13, 0xe0, 0xca, 0xec, 0x62, 0xe8, 0xd6, 0x1c, 0x03, 0xfd, 0xf5, 0x91, 0xd6,
-	0x1f, 0x5e, 0x21, 0x39, 0x19, 0xbd, 0x02, 0xf4, 0x23, 0xe0, 0xf2, 0xcc, 0x5a, 0xe8, 0x83, 0x05,
-	0x70, 0x97, 0x9f, 0x68, 0x7d, 0x6b, 0xf1, 0xe0, 0x44, 0xa3, 0xfe, 0x68, 0x30, 0x64, 0xe4, 0x6c,
-	0xc8, 0xc8, 0xf9, 0x90, 0xc1, 0x87, 0x84, 0xc1, 0xb7, 0x84, 0xc1, 0xf7, 0x84, 0xc1, 0x20, 0x61,
-	0xf0, 0x23, 0x61, 0xf0, 0x33, 0x61, 0xe4, 0x3c, 0x61, 0xf0, 0x79, 0xc4, 0xc8, 0x60, 0xc4, 0xc8,
-	0xd9, 0x88, 0x91, 0xb7, 0x4b, 0xe3, 0xb3, 0x6d, 0xe7, 0xd3, 0x63, 0xbd, 0xff, 0x2b, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0xfd, 0x55, 0x2c, 0xc9, 0x03, 0x00, 0x00,
+type EvaluateStreamRequest struct {
+	Token          *Token    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Precision      Precision `protobuf:"varint,2,opt,name=precision,proto3,enum=com.github.charithe.calculator.v1.Precision" json:"precision,omitempty"`
+	FloatPrecision uint32    `protobuf:"varint,3,opt,name=float_precision,json=floatPrecision,proto3" json:"float_precision,omitempty"`
+	MaxStackDepth  uint32    `protobuf:"varint,4,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	Explain        bool      `protobuf:"varint,5,opt,name=explain,proto3" json:"explain,omitempty"`
 }
 
-func (x Operator) String() string {
-	s, ok := Operator_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *EvaluateStreamRequest) Reset()      { *m = EvaluateStreamRequest{} }
+func (*EvaluateStreamRequest) ProtoMessage() {}
+func (*EvaluateStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{8}
 }
-func (this *Operand) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Operand)
-	if !ok {
-		that2, ok := that.(Operand)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *EvaluateStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluateStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	return true
 }
-func (this *Token) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *EvaluateStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateStreamRequest.Merge(m, src)
+}
+func (m *EvaluateStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateStreamRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Token)
-	if !ok {
-		that2, ok := that.(Token)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.Token == nil {
-		if this.Token != nil {
-			return false
-		}
-	} else if this.Token == nil {
-		return false
-	} else if !this.Token.Equal(that1.Token) {
-		return false
+var xxx_messageInfo_EvaluateStreamRequest proto.InternalMessageInfo
+
+func (m *EvaluateStreamRequest) GetToken() *Token {
+	if m != nil {
+		return m.Token
 	}
-	return true
+	return nil
 }
-func (this *Token_Operand) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Token_Operand)
-	if !ok {
-		that2, ok := that.(Token_Operand)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *EvaluateStreamRequest) GetPrecision() Precision {
+	if m != nil {
+		return m.Precision
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return FLOAT64
+}
+
+func (m *EvaluateStreamRequest) GetFloatPrecision() uint32 {
+	if m != nil {
+		return m.FloatPrecision
 	}
-	if !this.Operand.Equal(that1.Operand) {
-		return false
+	return 0
+}
+
+func (m *EvaluateStreamRequest) GetMaxStackDepth() uint32 {
+	if m != nil {
+		return m.MaxStackDepth
 	}
-	return true
+	return 0
 }
-func (this *Token_Operator) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EvaluateStreamRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
 	}
+	return false
+}
 
-	that1, ok := that.(*Token_Operator)
-	if !ok {
-		that2, ok := that.(Token_Operator)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EvaluateStreamResponse struct {
+	Result         float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	ResultDecimal  string  `protobuf:"bytes,2,opt,name=result_decimal,json=resultDecimal,proto3" json:"result_decimal,omitempty"`
+	ResultFraction string  `protobuf:"bytes,3,opt,name=result_fraction,json=resultFraction,proto3" json:"result_fraction,omitempty"`
+	Trace          *Trace  `protobuf:"bytes,4,opt,name=trace,proto3" json:"trace,omitempty"`
+}
+
+func (m *EvaluateStreamResponse) Reset()      { *m = EvaluateStreamResponse{} }
+func (*EvaluateStreamResponse) ProtoMessage() {}
+func (*EvaluateStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{9}
+}
+func (m *EvaluateStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluateStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Operator != that1.Operator {
-		return false
-	}
-	return true
 }
-func (this *EvaluateStreamRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *EvaluateStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateStreamResponse.Merge(m, src)
+}
+func (m *EvaluateStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateStreamResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*EvaluateStreamRequest)
-	if !ok {
-		that2, ok := that.(EvaluateStreamRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_EvaluateStreamResponse proto.InternalMessageInfo
+
+func (m *EvaluateStreamResponse) GetResult() float64 {
+	if m != nil {
+		return m.Result
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *EvaluateStreamResponse) GetResultDecimal() string {
+	if m != nil {
+		return m.ResultDecimal
 	}
-	if !this.Token.Equal(that1.Token) {
-		return false
+	return ""
+}
+
+func (m *EvaluateStreamResponse) GetResultFraction() string {
+	if m != nil {
+		return m.ResultFraction
 	}
-	return true
+	return ""
 }
-func (this *EvaluateStreamResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EvaluateStreamResponse) GetTrace() *Trace {
+	if m != nil {
+		return m.Trace
 	}
+	return nil
+}
 
-	that1, ok := that.(*EvaluateStreamResponse)
-	if !ok {
-		that2, ok := that.(EvaluateStreamResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EvaluateInteractiveRequest struct {
+	Token         *Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	MaxStackDepth uint32 `protobuf:"varint,2,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+}
+
+func (m *EvaluateInteractiveRequest) Reset()      { *m = EvaluateInteractiveRequest{} }
+func (*EvaluateInteractiveRequest) ProtoMessage() {}
+func (*EvaluateInteractiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{10}
+}
+func (m *EvaluateInteractiveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluateInteractiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateInteractiveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Result != that1.Result {
-		return false
+}
+func (m *EvaluateInteractiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateInteractiveRequest.Merge(m, src)
+}
+func (m *EvaluateInteractiveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateInteractiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateInteractiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateInteractiveRequest proto.InternalMessageInfo
+
+func (m *EvaluateInteractiveRequest) GetToken() *Token {
+	if m != nil {
+		return m.Token
 	}
-	return true
+	return nil
 }
-func (this *EvaluateBatchRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EvaluateInteractiveRequest) GetMaxStackDepth() uint32 {
+	if m != nil {
+		return m.MaxStackDepth
 	}
+	return 0
+}
 
-	that1, ok := that.(*EvaluateBatchRequest)
-	if !ok {
-		that2, ok := that.(EvaluateBatchRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EvaluateInteractiveResponse struct {
+	StackTop   float64 `protobuf:"fixed64,1,opt,name=stack_top,json=stackTop,proto3" json:"stack_top,omitempty"`
+	StackDepth int32   `protobuf:"varint,2,opt,name=stack_depth,json=stackDepth,proto3" json:"stack_depth,omitempty"`
+	Error      string  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EvaluateInteractiveResponse) Reset()      { *m = EvaluateInteractiveResponse{} }
+func (*EvaluateInteractiveResponse) ProtoMessage() {}
+func (*EvaluateInteractiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{11}
+}
+func (m *EvaluateInteractiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluateInteractiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateInteractiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Tokens) != len(that1.Tokens) {
-		return false
+}
+func (m *EvaluateInteractiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateInteractiveResponse.Merge(m, src)
+}
+func (m *EvaluateInteractiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateInteractiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateInteractiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateInteractiveResponse proto.InternalMessageInfo
+
+func (m *EvaluateInteractiveResponse) GetStackTop() float64 {
+	if m != nil {
+		return m.StackTop
 	}
-	for i := range this.Tokens {
-		if !this.Tokens[i].Equal(that1.Tokens[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *EvaluateInteractiveResponse) GetStackDepth() int32 {
+	if m != nil {
+		return m.StackDepth
 	}
-	return true
+	return 0
 }
-func (this *EvaluateBatchResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EvaluateInteractiveResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*EvaluateBatchResponse)
-	if !ok {
-		that2, ok := that.(EvaluateBatchResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EvaluateBatchRequest struct {
+	Tokens         []*Token            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Precision      Precision           `protobuf:"varint,2,opt,name=precision,proto3,enum=com.github.charithe.calculator.v1.Precision" json:"precision,omitempty"`
+	FloatPrecision uint32              `protobuf:"varint,3,opt,name=float_precision,json=floatPrecision,proto3" json:"float_precision,omitempty"`
+	MaxStackDepth  uint32              `protobuf:"varint,4,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	Bindings       map[string]*Operand `protobuf:"bytes,5,rep,name=bindings,proto3" json:"bindings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Explain        bool                `protobuf:"varint,6,opt,name=explain,proto3" json:"explain,omitempty"`
+	Expression     *Expression         `protobuf:"bytes,7,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (m *EvaluateBatchRequest) Reset()      { *m = EvaluateBatchRequest{} }
+func (*EvaluateBatchRequest) ProtoMessage() {}
+func (*EvaluateBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{12}
+}
+func (m *EvaluateBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluateBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EvaluateBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateBatchRequest.Merge(m, src)
+}
+func (m *EvaluateBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateBatchRequest proto.InternalMessageInfo
+
+func (m *EvaluateBatchRequest) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
 	}
-	if this.Result != that1.Result {
-		return false
+	return nil
+}
+
+func (m *EvaluateBatchRequest) GetPrecision() Precision {
+	if m != nil {
+		return m.Precision
 	}
-	return true
+	return FLOAT64
 }
-func (this *Operand) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *EvaluateBatchRequest) GetFloatPrecision() uint32 {
+	if m != nil {
+		return m.FloatPrecision
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&v1pb.Operand{")
-	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *Token) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *EvaluateBatchRequest) GetMaxStackDepth() uint32 {
+	if m != nil {
+		return m.MaxStackDepth
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&v1pb.Token{")
-	if this.Token != nil {
-		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	return 0
+}
+
+func (m *EvaluateBatchRequest) GetBindings() map[string]*Operand {
+	if m != nil {
+		return m.Bindings
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *Token_Operand) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *EvaluateBatchRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
 	}
-	s := strings.Join([]string{`&v1pb.Token_Operand{` +
-		`Operand:` + fmt.Sprintf("%#v", this.Operand) + `}`}, ", ")
-	return s
+	return false
 }
-func (this *Token_Operator) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *EvaluateBatchRequest) GetExpression() *Expression {
+	if m != nil {
+		return m.Expression
 	}
-	s := strings.Join([]string{`&v1pb.Token_Operator{` +
-		`Operator:` + fmt.Sprintf("%#v", this.Operator) + `}`}, ", ")
-	return s
+	return nil
 }
-func (this *EvaluateStreamRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&v1pb.EvaluateStreamRequest{")
-	if this.Token != nil {
-		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+type EvaluateBatchResponse struct {
+	Result         float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	ResultDecimal  string  `protobuf:"bytes,2,opt,name=result_decimal,json=resultDecimal,proto3" json:"result_decimal,omitempty"`
+	ResultFraction string  `protobuf:"bytes,3,opt,name=result_fraction,json=resultFraction,proto3" json:"result_fraction,omitempty"`
+	Trace          *Trace  `protobuf:"bytes,4,opt,name=trace,proto3" json:"trace,omitempty"`
 }
-func (this *EvaluateStreamResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&v1pb.EvaluateStreamResponse{")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *EvaluateBatchResponse) Reset()      { *m = EvaluateBatchResponse{} }
+func (*EvaluateBatchResponse) ProtoMessage() {}
+func (*EvaluateBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{13}
 }
-func (this *EvaluateBatchRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&v1pb.EvaluateBatchRequest{")
-	if this.Tokens != nil {
-		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *EvaluateBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *EvaluateBatchResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *EvaluateBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&v1pb.EvaluateBatchResponse{")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func valueToGoStringCalculator(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
-	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+func (m *EvaluateBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateBatchResponse.Merge(m, src)
+}
+func (m *EvaluateBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateBatchResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_EvaluateBatchResponse proto.InternalMessageInfo
 
-// CalculatorClient is the client API for Calculator service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CalculatorClient interface {
-	EvaluateStream(ctx context.Context, opts ...grpc.CallOption) (Calculator_EvaluateStreamClient, error)
-	EvaluateBatch(ctx context.Context, in *EvaluateBatchRequest, opts ...grpc.CallOption) (*EvaluateBatchResponse, error)
+func (m *EvaluateBatchResponse) GetResult() float64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
 }
 
-type calculatorClient struct {
-	cc *grpc.ClientConn
+func (m *EvaluateBatchResponse) GetResultDecimal() string {
+	if m != nil {
+		return m.ResultDecimal
+	}
+	return ""
 }
 
-func NewCalculatorClient(cc *grpc.ClientConn) CalculatorClient {
-	return &calculatorClient{cc}
+func (m *EvaluateBatchResponse) GetResultFraction() string {
+	if m != nil {
+		return m.ResultFraction
+	}
+	return ""
 }
 
-func (c *calculatorClient) EvaluateStream(ctx context.Context, opts ...grpc.CallOption) (Calculator_EvaluateStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[0], "/com.github.charithe.calculator.v1.Calculator/EvaluateStream", opts...)
-	if err != nil {
-		return nil, err
+func (m *EvaluateBatchResponse) GetTrace() *Trace {
+	if m != nil {
+		return m.Trace
 	}
-	x := &calculatorEvaluateStreamClient{stream}
-	return x, nil
+	return nil
 }
 
-type Calculator_EvaluateStreamClient interface {
-	Send(*EvaluateStreamRequest) error
-	CloseAndRecv() (*EvaluateStreamResponse, error)
-	grpc.ClientStream
+type TraceStep struct {
+	Token string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Stack []string `protobuf:"bytes,2,rep,name=stack,proto3" json:"stack,omitempty"`
 }
 
-type calculatorEvaluateStreamClient struct {
-	grpc.ClientStream
+func (m *TraceStep) Reset()      { *m = TraceStep{} }
+func (*TraceStep) ProtoMessage() {}
+func (*TraceStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{14}
 }
-
-func (x *calculatorEvaluateStreamClient) Send(m *EvaluateStreamRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *TraceStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *calculatorEvaluateStreamClient) CloseAndRecv() (*EvaluateStreamResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(EvaluateStreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TraceStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *TraceStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceStep.Merge(m, src)
+}
+func (m *TraceStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceStep.DiscardUnknown(m)
 }
 
-func (c *calculatorClient) EvaluateBatch(ctx context.Context, in *EvaluateBatchRequest, opts ...grpc.CallOption) (*EvaluateBatchResponse, error) {
-	out := new(EvaluateBatchResponse)
-	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/EvaluateBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TraceStep proto.InternalMessageInfo
+
+func (m *TraceStep) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return out, nil
+	return ""
 }
 
-// CalculatorServer is the server API for Calculator service.
-type CalculatorServer interface {
-	EvaluateStream(Calculator_EvaluateStreamServer) error
-	EvaluateBatch(context.Context, *EvaluateBatchRequest) (*EvaluateBatchResponse, error)
+func (m *TraceStep) GetStack() []string {
+	if m != nil {
+		return m.Stack
+	}
+	return nil
 }
 
-func RegisterCalculatorServer(s *grpc.Server, srv CalculatorServer) {
-	s.RegisterService(&_Calculator_serviceDesc, srv)
+type Trace struct {
+	Steps     []*TraceStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	Truncated bool         `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
-func _Calculator_EvaluateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(CalculatorServer).EvaluateStream(&calculatorEvaluateStreamServer{stream})
+func (m *Trace) Reset()      { *m = Trace{} }
+func (*Trace) ProtoMessage() {}
+func (*Trace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{15}
+}
+func (m *Trace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trace.Merge(m, src)
+}
+func (m *Trace) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trace) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trace.DiscardUnknown(m)
 }
 
-type Calculator_EvaluateStreamServer interface {
-	SendAndClose(*EvaluateStreamResponse) error
-	Recv() (*EvaluateStreamRequest, error)
-	grpc.ServerStream
+var xxx_messageInfo_Trace proto.InternalMessageInfo
+
+func (m *Trace) GetSteps() []*TraceStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
 }
 
-type calculatorEvaluateStreamServer struct {
-	grpc.ServerStream
+func (m *Trace) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
 }
 
-func (x *calculatorEvaluateStreamServer) SendAndClose(m *EvaluateStreamResponse) error {
-	return x.ServerStream.SendMsg(m)
+type EvaluateExpressionRequest struct {
+	Expression    string              `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Notation      Notation            `protobuf:"varint,2,opt,name=notation,proto3,enum=com.github.charithe.calculator.v1.Notation" json:"notation,omitempty"`
+	MaxStackDepth uint32              `protobuf:"varint,3,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+	Bindings      map[string]*Operand `protobuf:"bytes,4,rep,name=bindings,proto3" json:"bindings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *calculatorEvaluateStreamServer) Recv() (*EvaluateStreamRequest, error) {
-	m := new(EvaluateStreamRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *EvaluateExpressionRequest) Reset()      { *m = EvaluateExpressionRequest{} }
+func (*EvaluateExpressionRequest) ProtoMessage() {}
+func (*EvaluateExpressionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{16}
+}
+func (m *EvaluateExpressionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluateExpressionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateExpressionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *EvaluateExpressionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateExpressionRequest.Merge(m, src)
+}
+func (m *EvaluateExpressionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateExpressionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateExpressionRequest.DiscardUnknown(m)
 }
 
-func _Calculator_EvaluateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EvaluateBatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_EvaluateExpressionRequest proto.InternalMessageInfo
+
+func (m *EvaluateExpressionRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
 	}
-	if interceptor == nil {
-		return srv.(CalculatorServer).EvaluateBatch(ctx, in)
+	return ""
+}
+
+func (m *EvaluateExpressionRequest) GetNotation() Notation {
+	if m != nil {
+		return m.Notation
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/com.github.charithe.calculator.v1.Calculator/EvaluateBatch",
+	return RPN
+}
+
+func (m *EvaluateExpressionRequest) GetMaxStackDepth() uint32 {
+	if m != nil {
+		return m.MaxStackDepth
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CalculatorServer).EvaluateBatch(ctx, req.(*EvaluateBatchRequest))
+	return 0
+}
+
+func (m *EvaluateExpressionRequest) GetBindings() map[string]*Operand {
+	if m != nil {
+		return m.Bindings
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Calculator_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "com.github.charithe.calculator.v1.Calculator",
-	HandlerType: (*CalculatorServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EvaluateBatch",
-			Handler:    _Calculator_EvaluateBatch_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "EvaluateStream",
-			Handler:       _Calculator_EvaluateStream_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pkg/v1pb/calculator.proto",
+type EvaluateExpressionResponse struct {
+	Result float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *Operand) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EvaluateExpressionResponse) Reset()      { *m = EvaluateExpressionResponse{} }
+func (*EvaluateExpressionResponse) ProtoMessage() {}
+func (*EvaluateExpressionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{17}
 }
-
-func (m *Operand) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		dAtA[i] = 0x9
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
-		i += 8
+func (m *EvaluateExpressionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluateExpressionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateExpressionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *EvaluateExpressionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateExpressionResponse.Merge(m, src)
+}
+func (m *EvaluateExpressionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateExpressionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateExpressionResponse.DiscardUnknown(m)
 }
 
-func (m *Token) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EvaluateExpressionResponse proto.InternalMessageInfo
+
+func (m *EvaluateExpressionResponse) GetResult() float64 {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Token) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Token != nil {
-		nn1, err := m.Token.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn1
-	}
-	return i, nil
+type Column struct {
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
 }
 
-func (m *Token_Operand) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Operand != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCalculator(dAtA, i, uint64(m.Operand.Size()))
-		n2, err := m.Operand.MarshalTo(dAtA[i:])
+func (m *Column) Reset()      { *m = Column{} }
+func (*Column) ProtoMessage() {}
+func (*Column) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{18}
+}
+func (m *Column) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Column) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Column.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
+		return b[:n], nil
 	}
-	return i, nil
 }
-func (m *Token_Operator) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintCalculator(dAtA, i, uint64(m.Operator))
-	return i, nil
+func (m *Column) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Column.Merge(m, src)
 }
-func (m *EvaluateStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Column) XXX_Size() int {
+	return m.Size()
+}
+func (m *Column) XXX_DiscardUnknown() {
+	xxx_messageInfo_Column.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Column proto.InternalMessageInfo
+
+func (m *Column) GetValues() []float64 {
+	if m != nil {
+		return m.Values
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EvaluateStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Token != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCalculator(dAtA, i, uint64(m.Token.Size()))
-		n3, err := m.Token.MarshalTo(dAtA[i:])
+type EvaluateVectorRequest struct {
+	Tokens        []*Token           `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Columns       map[string]*Column `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaxStackDepth uint32             `protobuf:"varint,3,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+}
+
+func (m *EvaluateVectorRequest) Reset()      { *m = EvaluateVectorRequest{} }
+func (*EvaluateVectorRequest) ProtoMessage() {}
+func (*EvaluateVectorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{19}
+}
+func (m *EvaluateVectorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluateVectorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateVectorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *EvaluateVectorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateVectorRequest.Merge(m, src)
+}
+func (m *EvaluateVectorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateVectorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateVectorRequest.DiscardUnknown(m)
 }
 
-func (m *EvaluateStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EvaluateVectorRequest proto.InternalMessageInfo
+
+func (m *EvaluateVectorRequest) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EvaluateStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Result != 0 {
-		dAtA[i] = 0x9
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Result))))
-		i += 8
+func (m *EvaluateVectorRequest) GetColumns() map[string]*Column {
+	if m != nil {
+		return m.Columns
 	}
-	return i, nil
+	return nil
 }
 
-func (m *EvaluateBatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *EvaluateVectorRequest) GetMaxStackDepth() uint32 {
+	if m != nil {
+		return m.MaxStackDepth
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EvaluateBatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for _, msg := range m.Tokens {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+type RowError struct {
+	Row     uint32           `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Message string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error   *EvaluationError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *EvaluateBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RowError) Reset()      { *m = RowError{} }
+func (*RowError) ProtoMessage() {}
+func (*RowError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{20}
+}
+func (m *RowError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RowError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *RowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RowError.Merge(m, src)
+}
+func (m *RowError) XXX_Size() int {
+	return m.Size()
+}
+func (m *RowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_RowError.DiscardUnknown(m)
 }
 
-func (m *EvaluateBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Result != 0 {
-		dAtA[i] = 0x9
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Result))))
-		i += 8
+var xxx_messageInfo_RowError proto.InternalMessageInfo
+
+func (m *RowError) GetRow() uint32 {
+	if m != nil {
+		return m.Row
 	}
-	return i, nil
+	return 0
 }
 
-func encodeVarintCalculator(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RowError) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return ""
 }
-func (m *Operand) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 9
+
+func (m *RowError) GetError() *EvaluationError {
+	if m != nil {
+		return m.Error
 	}
-	return n
+	return nil
 }
 
-func (m *Token) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Token != nil {
-		n += m.Token.Size()
-	}
-	return n
+type EvaluateVectorResponse struct {
+	Results []float64   `protobuf:"fixed64,1,rep,packed,name=results,proto3" json:"results,omitempty"`
+	Errors  []*RowError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (m *Token_Operand) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Operand != nil {
-		l = m.Operand.Size()
-		n += 1 + l + sovCalculator(uint64(l))
-	}
-	return n
+func (m *EvaluateVectorResponse) Reset()      { *m = EvaluateVectorResponse{} }
+func (*EvaluateVectorResponse) ProtoMessage() {}
+func (*EvaluateVectorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{21}
 }
-func (m *Token_Operator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovCalculator(uint64(m.Operator))
-	return n
+func (m *EvaluateVectorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *EvaluateStreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EvaluateVectorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluateVectorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovCalculator(uint64(l))
+}
+func (m *EvaluateVectorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateVectorResponse.Merge(m, src)
+}
+func (m *EvaluateVectorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluateVectorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateVectorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateVectorResponse proto.InternalMessageInfo
+
+func (m *EvaluateVectorResponse) GetResults() []float64 {
+	if m != nil {
+		return m.Results
 	}
-	return n
+	return nil
 }
 
-func (m *EvaluateStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EvaluateVectorResponse) GetErrors() []*RowError {
+	if m != nil {
+		return m.Errors
 	}
-	var l int
-	_ = l
-	if m.Result != 0 {
-		n += 9
+	return nil
+}
+
+type CompileRequest struct {
+	Tokens []*Token `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (m *CompileRequest) Reset()      { *m = CompileRequest{} }
+func (*CompileRequest) ProtoMessage() {}
+func (*CompileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{22}
+}
+func (m *CompileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *CompileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompileRequest.Merge(m, src)
+}
+func (m *CompileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompileRequest.DiscardUnknown(m)
 }
 
-func (m *EvaluateBatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_CompileRequest proto.InternalMessageInfo
+
+func (m *CompileRequest) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
 	}
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovCalculator(uint64(l))
+	return nil
+}
+
+type CompileResponse struct {
+	ProgramId  string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	StackDepth uint32 `protobuf:"varint,2,opt,name=stack_depth,json=stackDepth,proto3" json:"stack_depth,omitempty"`
+}
+
+func (m *CompileResponse) Reset()      { *m = CompileResponse{} }
+func (*CompileResponse) ProtoMessage() {}
+func (*CompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{23}
+}
+func (m *CompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *CompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompileResponse.Merge(m, src)
+}
+func (m *CompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompileResponse.DiscardUnknown(m)
 }
 
-func (m *EvaluateBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_CompileResponse proto.InternalMessageInfo
+
+func (m *CompileResponse) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
 	}
-	var l int
-	_ = l
-	if m.Result != 0 {
-		n += 9
+	return ""
+}
+
+func (m *CompileResponse) GetStackDepth() uint32 {
+	if m != nil {
+		return m.StackDepth
 	}
-	return n
+	return 0
 }
 
-func sovCalculator(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+type ExecuteRequest struct {
+	ProgramId      string              `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Bindings       map[string]*Operand `protobuf:"bytes,2,rep,name=bindings,proto3" json:"bindings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Precision      Precision           `protobuf:"varint,3,opt,name=precision,proto3,enum=com.github.charithe.calculator.v1.Precision" json:"precision,omitempty"`
+	FloatPrecision uint32              `protobuf:"varint,4,opt,name=float_precision,json=floatPrecision,proto3" json:"float_precision,omitempty"`
+}
+
+func (m *ExecuteRequest) Reset()      { *m = ExecuteRequest{} }
+func (*ExecuteRequest) ProtoMessage() {}
+func (*ExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{24}
+}
+func (m *ExecuteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-func sozCalculator(x uint64) (n int) {
-	return sovCalculator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ExecuteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteRequest.Merge(m, src)
 }
-func (this *Operand) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Operand{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ExecuteRequest) XXX_Size() int {
+	return m.Size()
 }
-func (this *Token) String() string {
-	if this == nil {
-		return "nil"
+func (m *ExecuteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteRequest proto.InternalMessageInfo
+
+func (m *ExecuteRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
 	}
-	s := strings.Join([]string{`&Token{`,
-		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
-		`}`,
-	}, "")
-	return s
+	return ""
 }
-func (this *Token_Operand) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ExecuteRequest) GetBindings() map[string]*Operand {
+	if m != nil {
+		return m.Bindings
 	}
-	s := strings.Join([]string{`&Token_Operand{`,
-		`Operand:` + strings.Replace(fmt.Sprintf("%v", this.Operand), "Operand", "Operand", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *Token_Operator) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ExecuteRequest) GetPrecision() Precision {
+	if m != nil {
+		return m.Precision
 	}
-	s := strings.Join([]string{`&Token_Operator{`,
-		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
-		`}`,
-	}, "")
-	return s
+	return FLOAT64
 }
-func (this *EvaluateStreamRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ExecuteRequest) GetFloatPrecision() uint32 {
+	if m != nil {
+		return m.FloatPrecision
 	}
-	s := strings.Join([]string{`&EvaluateStreamRequest{`,
-		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "Token", "Token", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func (this *EvaluateStreamResponse) String() string {
-	if this == nil {
-		return "nil"
+
+type ExecuteResponse struct {
+	Result         float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	ResultDecimal  string  `protobuf:"bytes,2,opt,name=result_decimal,json=resultDecimal,proto3" json:"result_decimal,omitempty"`
+	ResultFraction string  `protobuf:"bytes,3,opt,name=result_fraction,json=resultFraction,proto3" json:"result_fraction,omitempty"`
+}
+
+func (m *ExecuteResponse) Reset()      { *m = ExecuteResponse{} }
+func (*ExecuteResponse) ProtoMessage() {}
+func (*ExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{25}
+}
+func (m *ExecuteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&EvaluateStreamResponse{`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *EvaluateBatchRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *ExecuteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteResponse.Merge(m, src)
+}
+func (m *ExecuteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteResponse proto.InternalMessageInfo
+
+func (m *ExecuteResponse) GetResult() float64 {
+	if m != nil {
+		return m.Result
 	}
-	s := strings.Join([]string{`&EvaluateBatchRequest{`,
-		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func (this *EvaluateBatchResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ExecuteResponse) GetResultDecimal() string {
+	if m != nil {
+		return m.ResultDecimal
 	}
-	s := strings.Join([]string{`&EvaluateBatchResponse{`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+	return ""
 }
-func valueToStringCalculator(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *ExecuteResponse) GetResultFraction() string {
+	if m != nil {
+		return m.ResultFraction
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return ""
 }
-func (m *Operand) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCalculator
-			}
+
+type ValidateRequest struct {
+	Expression    string   `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Notation      Notation `protobuf:"varint,2,opt,name=notation,proto3,enum=com.github.charithe.calculator.v1.Notation" json:"notation,omitempty"`
+	Tokens        []*Token `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	MaxStackDepth uint32   `protobuf:"varint,4,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+}
+
+func (m *ValidateRequest) Reset()      { *m = ValidateRequest{} }
+func (*ValidateRequest) ProtoMessage() {}
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{26}
+}
+func (m *ValidateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateRequest.Merge(m, src)
+}
+func (m *ValidateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateRequest proto.InternalMessageInfo
+
+func (m *ValidateRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *ValidateRequest) GetNotation() Notation {
+	if m != nil {
+		return m.Notation
+	}
+	return RPN
+}
+
+func (m *ValidateRequest) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ValidateRequest) GetMaxStackDepth() uint32 {
+	if m != nil {
+		return m.MaxStackDepth
+	}
+	return 0
+}
+
+type ValidationProblem struct {
+	Message  string           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Error    *EvaluationError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Position int32            `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (m *ValidationProblem) Reset()      { *m = ValidationProblem{} }
+func (*ValidationProblem) ProtoMessage() {}
+func (*ValidationProblem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{27}
+}
+func (m *ValidationProblem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationProblem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationProblem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidationProblem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationProblem.Merge(m, src)
+}
+func (m *ValidationProblem) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationProblem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationProblem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationProblem proto.InternalMessageInfo
+
+func (m *ValidationProblem) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ValidationProblem) GetError() *EvaluationError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ValidationProblem) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+type ValidateResponse struct {
+	Valid         bool                 `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Problems      []*ValidationProblem `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+	MaxStackDepth uint32               `protobuf:"varint,3,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+}
+
+func (m *ValidateResponse) Reset()      { *m = ValidateResponse{} }
+func (*ValidateResponse) ProtoMessage() {}
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{28}
+}
+func (m *ValidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateResponse.Merge(m, src)
+}
+func (m *ValidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateResponse proto.InternalMessageInfo
+
+func (m *ValidateResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateResponse) GetProblems() []*ValidationProblem {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
+}
+
+func (m *ValidateResponse) GetMaxStackDepth() uint32 {
+	if m != nil {
+		return m.MaxStackDepth
+	}
+	return 0
+}
+
+type ConvertRequest struct {
+	Expression     string   `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Notation       Notation `protobuf:"varint,2,opt,name=notation,proto3,enum=com.github.charithe.calculator.v1.Notation" json:"notation,omitempty"`
+	Tokens         []*Token `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	TargetNotation Notation `protobuf:"varint,4,opt,name=target_notation,json=targetNotation,proto3,enum=com.github.charithe.calculator.v1.Notation" json:"target_notation,omitempty"`
+}
+
+func (m *ConvertRequest) Reset()      { *m = ConvertRequest{} }
+func (*ConvertRequest) ProtoMessage() {}
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{29}
+}
+func (m *ConvertRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertRequest.Merge(m, src)
+}
+func (m *ConvertRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertRequest proto.InternalMessageInfo
+
+func (m *ConvertRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *ConvertRequest) GetNotation() Notation {
+	if m != nil {
+		return m.Notation
+	}
+	return RPN
+}
+
+func (m *ConvertRequest) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ConvertRequest) GetTargetNotation() Notation {
+	if m != nil {
+		return m.TargetNotation
+	}
+	return RPN
+}
+
+type ConvertResponse struct {
+	Expression string   `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Tokens     []*Token `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (m *ConvertResponse) Reset()      { *m = ConvertResponse{} }
+func (*ConvertResponse) ProtoMessage() {}
+func (*ConvertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{30}
+}
+func (m *ConvertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertResponse.Merge(m, src)
+}
+func (m *ConvertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertResponse proto.InternalMessageInfo
+
+func (m *ConvertResponse) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *ConvertResponse) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type CreateSessionRequest struct {
+	Precision      Precision `protobuf:"varint,1,opt,name=precision,proto3,enum=com.github.charithe.calculator.v1.Precision" json:"precision,omitempty"`
+	FloatPrecision uint32    `protobuf:"varint,2,opt,name=float_precision,json=floatPrecision,proto3" json:"float_precision,omitempty"`
+	MaxStackDepth  uint32    `protobuf:"varint,3,opt,name=max_stack_depth,json=maxStackDepth,proto3" json:"max_stack_depth,omitempty"`
+}
+
+func (m *CreateSessionRequest) Reset()      { *m = CreateSessionRequest{} }
+func (*CreateSessionRequest) ProtoMessage() {}
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{31}
+}
+func (m *CreateSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSessionRequest.Merge(m, src)
+}
+func (m *CreateSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSessionRequest proto.InternalMessageInfo
+
+func (m *CreateSessionRequest) GetPrecision() Precision {
+	if m != nil {
+		return m.Precision
+	}
+	return FLOAT64
+}
+
+func (m *CreateSessionRequest) GetFloatPrecision() uint32 {
+	if m != nil {
+		return m.FloatPrecision
+	}
+	return 0
+}
+
+func (m *CreateSessionRequest) GetMaxStackDepth() uint32 {
+	if m != nil {
+		return m.MaxStackDepth
+	}
+	return 0
+}
+
+type Session struct {
+	Id        string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stack     []string          `protobuf:"bytes,2,rep,name=stack,proto3" json:"stack,omitempty"`
+	Registers map[string]string `protobuf:"bytes,3,rep,name=registers,proto3" json:"registers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Session) Reset()      { *m = Session{} }
+func (*Session) ProtoMessage() {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{32}
+}
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
+}
+func (m *Session) XXX_Size() int {
+	return m.Size()
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Session) GetStack() []string {
+	if m != nil {
+		return m.Stack
+	}
+	return nil
+}
+
+func (m *Session) GetRegisters() map[string]string {
+	if m != nil {
+		return m.Registers
+	}
+	return nil
+}
+
+type CreateSessionResponse struct {
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (m *CreateSessionResponse) Reset()      { *m = CreateSessionResponse{} }
+func (*CreateSessionResponse) ProtoMessage() {}
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{33}
+}
+func (m *CreateSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSessionResponse.Merge(m, src)
+}
+func (m *CreateSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSessionResponse proto.InternalMessageInfo
+
+func (m *CreateSessionResponse) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+type PushRequest struct {
+	SessionId string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Tokens    []*Token `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (m *PushRequest) Reset()      { *m = PushRequest{} }
+func (*PushRequest) ProtoMessage() {}
+func (*PushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{34}
+}
+func (m *PushRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushRequest.Merge(m, src)
+}
+func (m *PushRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushRequest proto.InternalMessageInfo
+
+func (m *PushRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *PushRequest) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type PushResponse struct {
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (m *PushResponse) Reset()      { *m = PushResponse{} }
+func (*PushResponse) ProtoMessage() {}
+func (*PushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{35}
+}
+func (m *PushResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushResponse.Merge(m, src)
+}
+func (m *PushResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushResponse proto.InternalMessageInfo
+
+func (m *PushResponse) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+type GetSessionRequest struct {
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (m *GetSessionRequest) Reset()      { *m = GetSessionRequest{} }
+func (*GetSessionRequest) ProtoMessage() {}
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{36}
+}
+func (m *GetSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSessionRequest.Merge(m, src)
+}
+func (m *GetSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSessionRequest proto.InternalMessageInfo
+
+func (m *GetSessionRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type GetSessionResponse struct {
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (m *GetSessionResponse) Reset()      { *m = GetSessionResponse{} }
+func (*GetSessionResponse) ProtoMessage() {}
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{37}
+}
+func (m *GetSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSessionResponse.Merge(m, src)
+}
+func (m *GetSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSessionResponse proto.InternalMessageInfo
+
+func (m *GetSessionResponse) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+type DeleteSessionRequest struct {
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (m *DeleteSessionRequest) Reset()      { *m = DeleteSessionRequest{} }
+func (*DeleteSessionRequest) ProtoMessage() {}
+func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{38}
+}
+func (m *DeleteSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSessionRequest.Merge(m, src)
+}
+func (m *DeleteSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSessionRequest proto.InternalMessageInfo
+
+func (m *DeleteSessionRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type DeleteSessionResponse struct {
+}
+
+func (m *DeleteSessionResponse) Reset()      { *m = DeleteSessionResponse{} }
+func (*DeleteSessionResponse) ProtoMessage() {}
+func (*DeleteSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{39}
+}
+func (m *DeleteSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSessionResponse.Merge(m, src)
+}
+func (m *DeleteSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSessionResponse proto.InternalMessageInfo
+
+type HistoryEntry struct {
+	Id            uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimeUnixNano  int64       `protobuf:"varint,2,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	Method        string      `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Caller        string      `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	Tokens        []*Token    `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Expression    *Expression `protobuf:"bytes,6,opt,name=expression,proto3" json:"expression,omitempty"`
+	Precision     Precision   `protobuf:"varint,7,opt,name=precision,proto3,enum=com.github.charithe.calculator.v1.Precision" json:"precision,omitempty"`
+	Result        float64     `protobuf:"fixed64,8,opt,name=result,proto3" json:"result,omitempty"`
+	ResultDecimal string      `protobuf:"bytes,9,opt,name=result_decimal,json=resultDecimal,proto3" json:"result_decimal,omitempty"`
+	Code          uint32      `protobuf:"varint,10,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string      `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	Truncated     bool        `protobuf:"varint,12,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *HistoryEntry) Reset()      { *m = HistoryEntry{} }
+func (*HistoryEntry) ProtoMessage() {}
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{40}
+}
+func (m *HistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryEntry.Merge(m, src)
+}
+func (m *HistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryEntry proto.InternalMessageInfo
+
+func (m *HistoryEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetTimeUnixNano() int64 {
+	if m != nil {
+		return m.TimeUnixNano
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetExpression() *Expression {
+	if m != nil {
+		return m.Expression
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetPrecision() Precision {
+	if m != nil {
+		return m.Precision
+	}
+	return FLOAT64
+}
+
+func (m *HistoryEntry) GetResult() float64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetResultDecimal() string {
+	if m != nil {
+		return m.ResultDecimal
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type ListHistoryRequest struct {
+	StartTimeUnixNano int64  `protobuf:"varint,1,opt,name=start_time_unix_nano,json=startTimeUnixNano,proto3" json:"start_time_unix_nano,omitempty"`
+	EndTimeUnixNano   int64  `protobuf:"varint,2,opt,name=end_time_unix_nano,json=endTimeUnixNano,proto3" json:"end_time_unix_nano,omitempty"`
+	PageSize          uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (m *ListHistoryRequest) Reset()      { *m = ListHistoryRequest{} }
+func (*ListHistoryRequest) ProtoMessage() {}
+func (*ListHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{41}
+}
+func (m *ListHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryRequest.Merge(m, src)
+}
+func (m *ListHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryRequest proto.InternalMessageInfo
+
+func (m *ListHistoryRequest) GetStartTimeUnixNano() int64 {
+	if m != nil {
+		return m.StartTimeUnixNano
+	}
+	return 0
+}
+
+func (m *ListHistoryRequest) GetEndTimeUnixNano() int64 {
+	if m != nil {
+		return m.EndTimeUnixNano
+	}
+	return 0
+}
+
+func (m *ListHistoryRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListHistoryRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListHistoryResponse struct {
+	Entries       []*HistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListHistoryResponse) Reset()      { *m = ListHistoryResponse{} }
+func (*ListHistoryResponse) ProtoMessage() {}
+func (*ListHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{42}
+}
+func (m *ListHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryResponse.Merge(m, src)
+}
+func (m *ListHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryResponse proto.InternalMessageInfo
+
+func (m *ListHistoryResponse) GetEntries() []*HistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ListHistoryResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ParseError struct {
+	Position int32  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *ParseError) Reset()      { *m = ParseError{} }
+func (*ParseError) ProtoMessage() {}
+func (*ParseError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{43}
+}
+func (m *ParseError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParseError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParseError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParseError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParseError.Merge(m, src)
+}
+func (m *ParseError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParseError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParseError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParseError proto.InternalMessageInfo
+
+func (m *ParseError) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *ParseError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type EvaluationError struct {
+	Reason     ErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=com.github.charithe.calculator.v1.ErrorReason" json:"reason,omitempty"`
+	TokenIndex int32       `protobuf:"varint,2,opt,name=token_index,json=tokenIndex,proto3" json:"token_index,omitempty"`
+	Token      string      `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	StackDepth int32       `protobuf:"varint,4,opt,name=stack_depth,json=stackDepth,proto3" json:"stack_depth,omitempty"`
+}
+
+func (m *EvaluationError) Reset()      { *m = EvaluationError{} }
+func (*EvaluationError) ProtoMessage() {}
+func (*EvaluationError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{44}
+}
+func (m *EvaluationError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvaluationError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvaluationError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvaluationError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluationError.Merge(m, src)
+}
+func (m *EvaluationError) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvaluationError) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluationError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluationError proto.InternalMessageInfo
+
+func (m *EvaluationError) GetReason() ErrorReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ERROR_REASON_UNSPECIFIED
+}
+
+func (m *EvaluationError) GetTokenIndex() int32 {
+	if m != nil {
+		return m.TokenIndex
+	}
+	return 0
+}
+
+func (m *EvaluationError) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *EvaluationError) GetStackDepth() int32 {
+	if m != nil {
+		return m.StackDepth
+	}
+	return 0
+}
+
+type StackDepthExceeded struct {
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *StackDepthExceeded) Reset()      { *m = StackDepthExceeded{} }
+func (*StackDepthExceeded) ProtoMessage() {}
+func (*StackDepthExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4015ff54a8a5a4, []int{45}
+}
+func (m *StackDepthExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StackDepthExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StackDepthExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StackDepthExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackDepthExceeded.Merge(m, src)
+}
+func (m *StackDepthExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *StackDepthExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackDepthExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackDepthExceeded proto.InternalMessageInfo
+
+func (m *StackDepthExceeded) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("com.github.charithe.calculator.v1.Operator", Operator_name, Operator_value)
+	proto.RegisterEnum("com.github.charithe.calculator.v1.Notation", Notation_name, Notation_value)
+	proto.RegisterEnum("com.github.charithe.calculator.v1.StackOp", StackOp_name, StackOp_value)
+	proto.RegisterEnum("com.github.charithe.calculator.v1.RegisterOp", RegisterOp_name, RegisterOp_value)
+	proto.RegisterEnum("com.github.charithe.calculator.v1.Precision", Precision_name, Precision_value)
+	proto.RegisterEnum("com.github.charithe.calculator.v1.ErrorReason", ErrorReason_name, ErrorReason_value)
+	proto.RegisterType((*Operand)(nil), "com.github.charithe.calculator.v1.Operand")
+	proto.RegisterType((*Function)(nil), "com.github.charithe.calculator.v1.Function")
+	proto.RegisterType((*Variable)(nil), "com.github.charithe.calculator.v1.Variable")
+	proto.RegisterType((*Register)(nil), "com.github.charithe.calculator.v1.Register")
+	proto.RegisterType((*Token)(nil), "com.github.charithe.calculator.v1.Token")
+	proto.RegisterType((*Expression)(nil), "com.github.charithe.calculator.v1.Expression")
+	proto.RegisterType((*BinaryExpression)(nil), "com.github.charithe.calculator.v1.BinaryExpression")
+	proto.RegisterType((*Call)(nil), "com.github.charithe.calculator.v1.Call")
+	proto.RegisterType((*EvaluateStreamRequest)(nil), "com.github.charithe.calculator.v1.EvaluateStreamRequest")
+	proto.RegisterType((*EvaluateStreamResponse)(nil), "com.github.charithe.calculator.v1.EvaluateStreamResponse")
+	proto.RegisterType((*EvaluateInteractiveRequest)(nil), "com.github.charithe.calculator.v1.EvaluateInteractiveRequest")
+	proto.RegisterType((*EvaluateInteractiveResponse)(nil), "com.github.charithe.calculator.v1.EvaluateInteractiveResponse")
+	proto.RegisterType((*EvaluateBatchRequest)(nil), "com.github.charithe.calculator.v1.EvaluateBatchRequest")
+	proto.RegisterMapType((map[string]*Operand)(nil), "com.github.charithe.calculator.v1.EvaluateBatchRequest.BindingsEntry")
+	proto.RegisterType((*EvaluateBatchResponse)(nil), "com.github.charithe.calculator.v1.EvaluateBatchResponse")
+	proto.RegisterType((*TraceStep)(nil), "com.github.charithe.calculator.v1.TraceStep")
+	proto.RegisterType((*Trace)(nil), "com.github.charithe.calculator.v1.Trace")
+	proto.RegisterType((*EvaluateExpressionRequest)(nil), "com.github.charithe.calculator.v1.EvaluateExpressionRequest")
+	proto.RegisterMapType((map[string]*Operand)(nil), "com.github.charithe.calculator.v1.EvaluateExpressionRequest.BindingsEntry")
+	proto.RegisterType((*EvaluateExpressionResponse)(nil), "com.github.charithe.calculator.v1.EvaluateExpressionResponse")
+	proto.RegisterType((*Column)(nil), "com.github.charithe.calculator.v1.Column")
+	proto.RegisterType((*EvaluateVectorRequest)(nil), "com.github.charithe.calculator.v1.EvaluateVectorRequest")
+	proto.RegisterMapType((map[string]*Column)(nil), "com.github.charithe.calculator.v1.EvaluateVectorRequest.ColumnsEntry")
+	proto.RegisterType((*RowError)(nil), "com.github.charithe.calculator.v1.RowError")
+	proto.RegisterType((*EvaluateVectorResponse)(nil), "com.github.charithe.calculator.v1.EvaluateVectorResponse")
+	proto.RegisterType((*CompileRequest)(nil), "com.github.charithe.calculator.v1.CompileRequest")
+	proto.RegisterType((*CompileResponse)(nil), "com.github.charithe.calculator.v1.CompileResponse")
+	proto.RegisterType((*ExecuteRequest)(nil), "com.github.charithe.calculator.v1.ExecuteRequest")
+	proto.RegisterMapType((map[string]*Operand)(nil), "com.github.charithe.calculator.v1.ExecuteRequest.BindingsEntry")
+	proto.RegisterType((*ExecuteResponse)(nil), "com.github.charithe.calculator.v1.ExecuteResponse")
+	proto.RegisterType((*ValidateRequest)(nil), "com.github.charithe.calculator.v1.ValidateRequest")
+	proto.RegisterType((*ValidationProblem)(nil), "com.github.charithe.calculator.v1.ValidationProblem")
+	proto.RegisterType((*ValidateResponse)(nil), "com.github.charithe.calculator.v1.ValidateResponse")
+	proto.RegisterType((*ConvertRequest)(nil), "com.github.charithe.calculator.v1.ConvertRequest")
+	proto.RegisterType((*ConvertResponse)(nil), "com.github.charithe.calculator.v1.ConvertResponse")
+	proto.RegisterType((*CreateSessionRequest)(nil), "com.github.charithe.calculator.v1.CreateSessionRequest")
+	proto.RegisterType((*Session)(nil), "com.github.charithe.calculator.v1.Session")
+	proto.RegisterMapType((map[string]string)(nil), "com.github.charithe.calculator.v1.Session.RegistersEntry")
+	proto.RegisterType((*CreateSessionResponse)(nil), "com.github.charithe.calculator.v1.CreateSessionResponse")
+	proto.RegisterType((*PushRequest)(nil), "com.github.charithe.calculator.v1.PushRequest")
+	proto.RegisterType((*PushResponse)(nil), "com.github.charithe.calculator.v1.PushResponse")
+	proto.RegisterType((*GetSessionRequest)(nil), "com.github.charithe.calculator.v1.GetSessionRequest")
+	proto.RegisterType((*GetSessionResponse)(nil), "com.github.charithe.calculator.v1.GetSessionResponse")
+	proto.RegisterType((*DeleteSessionRequest)(nil), "com.github.charithe.calculator.v1.DeleteSessionRequest")
+	proto.RegisterType((*DeleteSessionResponse)(nil), "com.github.charithe.calculator.v1.DeleteSessionResponse")
+	proto.RegisterType((*HistoryEntry)(nil), "com.github.charithe.calculator.v1.HistoryEntry")
+	proto.RegisterType((*ListHistoryRequest)(nil), "com.github.charithe.calculator.v1.ListHistoryRequest")
+	proto.RegisterType((*ListHistoryResponse)(nil), "com.github.charithe.calculator.v1.ListHistoryResponse")
+	proto.RegisterType((*ParseError)(nil), "com.github.charithe.calculator.v1.ParseError")
+	proto.RegisterType((*EvaluationError)(nil), "com.github.charithe.calculator.v1.EvaluationError")
+	proto.RegisterType((*StackDepthExceeded)(nil), "com.github.charithe.calculator.v1.StackDepthExceeded")
+}
+
+func init() { proto.RegisterFile("pkg/v1pb/calculator.proto", fileDescriptor_ce4015ff54a8a5a4) }
+
+var fileDescriptor_ce4015ff54a8a5a4 = []byte{
+	// 2641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcf, 0x8f, 0xdb, 0xc6,
+	0xf5, 0x17, 0xf5, 0x63, 0x25, 0xbd, 0xdd, 0xd5, 0xd2, 0xe3, 0xb5, 0xa3, 0x28, 0xf9, 0xea, 0xeb,
+	0x12, 0x6d, 0xe3, 0x6e, 0xd2, 0x75, 0xa3, 0x38, 0x89, 0x1d, 0xc4, 0x49, 0xf4, 0x83, 0xbb, 0xcb,
+	0x64, 0x97, 0x54, 0x47, 0xd2, 0xda, 0x4e, 0x11, 0x10, 0x5c, 0x69, 0xac, 0x25, 0x2c, 0x91, 0x0a,
+	0xc9, 0x75, 0x76, 0x83, 0x1e, 0x82, 0xa2, 0x45, 0xdb, 0x4b, 0xd1, 0xa0, 0x97, 0x9e, 0x7b, 0x2a,
+	0x7a, 0x28, 0x50, 0xf4, 0x58, 0x14, 0xed, 0xa9, 0x68, 0x0f, 0x05, 0x72, 0xcc, 0xb1, 0x5e, 0xf7,
+	0x50, 0xf4, 0x94, 0x7f, 0xa0, 0x40, 0x31, 0xc3, 0xa1, 0x44, 0xca, 0x72, 0x4c, 0xc9, 0x0b, 0xa3,
+	0xb9, 0x71, 0x86, 0xfa, 0xbc, 0xdf, 0xf3, 0xde, 0x9b, 0x47, 0xc1, 0xb3, 0xa3, 0xbb, 0xfd, 0x2b,
+	0xf7, 0x5e, 0x1e, 0x1d, 0x5c, 0xe9, 0x1a, 0x83, 0xee, 0xd1, 0xc0, 0xf0, 0x6c, 0x67, 0x73, 0xe4,
+	0xd8, 0x9e, 0x8d, 0xbe, 0xd6, 0xb5, 0x87, 0x9b, 0x7d, 0xd3, 0x3b, 0x3c, 0x3a, 0xd8, 0xec, 0x1e,
+	0x1a, 0x8e, 0xe9, 0x1d, 0x92, 0xcd, 0xd0, 0xaf, 0xee, 0xbd, 0x2c, 0x5d, 0x87, 0xac, 0x36, 0x22,
+	0x8e, 0x61, 0xf5, 0xd0, 0x3a, 0x64, 0xee, 0x19, 0x83, 0x23, 0x52, 0x14, 0x2e, 0x09, 0x97, 0x05,
+	0xec, 0x2f, 0x50, 0x11, 0xb2, 0x3d, 0xd2, 0x35, 0x87, 0xc6, 0xa0, 0x98, 0xbc, 0x24, 0x5c, 0xce,
+	0xe3, 0x60, 0x29, 0x5d, 0x85, 0xdc, 0xd6, 0x91, 0xd5, 0xf5, 0x4c, 0xdb, 0x42, 0x08, 0xd2, 0x96,
+	0x31, 0xf4, 0xa1, 0x79, 0xcc, 0x9e, 0x29, 0x3d, 0xca, 0xf2, 0x84, 0xe1, 0x32, 0xd8, 0x5f, 0x48,
+	0x65, 0xc8, 0xed, 0x1b, 0x8e, 0x69, 0x1c, 0x0c, 0xc8, 0x2c, 0x94, 0xf4, 0x01, 0xe4, 0x30, 0xe9,
+	0x9b, 0xae, 0x47, 0x1c, 0x74, 0x03, 0x92, 0xf6, 0x88, 0xbd, 0x2d, 0x54, 0xbe, 0xbd, 0xf9, 0x58,
+	0x65, 0x36, 0x03, 0xa0, 0x36, 0xc2, 0x49, 0x7b, 0x34, 0x26, 0x9f, 0x0c, 0x91, 0xff, 0x7b, 0x0a,
+	0x32, 0x6d, 0xfb, 0x2e, 0xb1, 0xd0, 0x16, 0x64, 0x6d, 0x5f, 0x73, 0xc6, 0x61, 0xb9, 0xb2, 0x11,
+	0x83, 0x03, 0xb7, 0xd5, 0x4e, 0x02, 0x07, 0x60, 0xa4, 0x40, 0x8e, 0x3d, 0x7a, 0xb6, 0xc3, 0x38,
+	0x15, 0x2a, 0x2f, 0xc6, 0x25, 0xe4, 0xd9, 0xce, 0x4e, 0x02, 0x8f, 0xe1, 0x94, 0xd4, 0x1d, 0x6e,
+	0xd1, 0x62, 0x8a, 0xc9, 0x14, 0x87, 0x54, 0xe0, 0x04, 0x4a, 0x2a, 0x80, 0xa3, 0x6d, 0xc8, 0xb9,
+	0x9e, 0xd1, 0xbd, 0xab, 0xdb, 0xa3, 0x62, 0x9a, 0x49, 0x15, 0x47, 0xbd, 0x16, 0x85, 0x68, 0x23,
+	0xaa, 0x9e, 0xeb, 0x3f, 0x52, 0x99, 0xee, 0x71, 0x7f, 0x15, 0x33, 0xb1, 0x65, 0x0a, 0x5c, 0x4c,
+	0x65, 0x0a, 0xe0, 0x94, 0x94, 0xc3, 0x3d, 0x54, 0x5c, 0x8a, 0x4d, 0x2a, 0x70, 0x2a, 0x25, 0x15,
+	0xc0, 0x6b, 0x59, 0xc8, 0x78, 0xd4, 0x8b, 0xd2, 0x1f, 0x92, 0x00, 0xf2, 0xf1, 0xc8, 0x21, 0xae,
+	0x4b, 0xd5, 0xde, 0x82, 0xec, 0xc0, 0xf4, 0x88, 0x63, 0x0c, 0x16, 0x73, 0x2a, 0x07, 0x47, 0xb4,
+	0x4e, 0x3e, 0x99, 0xd6, 0x7b, 0xb0, 0x74, 0x60, 0x5a, 0x86, 0x73, 0xc2, 0x5d, 0xfa, 0x4a, 0x0c,
+	0x42, 0x35, 0x06, 0x98, 0xe8, 0xb5, 0x93, 0xc0, 0x9c, 0x08, 0xba, 0x01, 0xe9, 0xae, 0x31, 0x18,
+	0x30, 0xa7, 0x2e, 0x57, 0x5e, 0x88, 0x41, 0xac, 0x6e, 0x0c, 0x06, 0x3b, 0x09, 0xcc, 0x60, 0xb5,
+	0x15, 0x00, 0x32, 0x26, 0x2b, 0x3d, 0x10, 0x40, 0x9c, 0xe6, 0x45, 0x43, 0x67, 0x1c, 0xd0, 0xc2,
+	0xdc, 0x01, 0x1d, 0x0a, 0xe7, 0x2a, 0xa4, 0x07, 0xe4, 0x8e, 0xc7, 0x0d, 0x18, 0xe7, 0x00, 0x4f,
+	0xa4, 0xc0, 0x0c, 0x8a, 0xea, 0x90, 0x71, 0xcc, 0xfe, 0xa1, 0x57, 0x4c, 0x2d, 0x42, 0xc3, 0xc7,
+	0x4a, 0x1f, 0x40, 0x9a, 0xda, 0x60, 0x66, 0x92, 0xaa, 0x42, 0xda, 0x70, 0xfa, 0x6e, 0x31, 0x79,
+	0x29, 0xb5, 0x80, 0x8c, 0x14, 0x2a, 0xfd, 0x2c, 0x09, 0x17, 0x64, 0x9a, 0x2c, 0x0d, 0x8f, 0xb4,
+	0x3c, 0x87, 0x18, 0x43, 0x4c, 0x3e, 0x3c, 0x22, 0xae, 0x87, 0xde, 0xe2, 0x51, 0xca, 0x63, 0xf1,
+	0x72, 0x0c, 0xea, 0x2c, 0x37, 0x61, 0x1f, 0x86, 0xde, 0x85, 0xfc, 0xc8, 0x21, 0x5d, 0x93, 0x32,
+	0xe3, 0xb9, 0xe5, 0xa5, 0x18, 0x34, 0x9a, 0x01, 0x06, 0x4f, 0xe0, 0xe8, 0x05, 0x58, 0xbb, 0x33,
+	0xb0, 0x0d, 0x4f, 0x9f, 0x50, 0xa4, 0x36, 0x5d, 0xc5, 0x05, 0xb6, 0x3d, 0xc6, 0xa0, 0x6f, 0xc2,
+	0xda, 0xd0, 0x38, 0xd6, 0xfd, 0xec, 0xd1, 0x23, 0x23, 0xef, 0x90, 0xc5, 0xda, 0x2a, 0x5e, 0x1d,
+	0x1a, 0xc7, 0x2c, 0x41, 0x34, 0xe8, 0x26, 0x2d, 0x0c, 0xe4, 0x78, 0x34, 0x30, 0x4c, 0x8b, 0xe5,
+	0x85, 0x1c, 0x0e, 0x96, 0xd2, 0x9f, 0x05, 0xb8, 0x38, 0x6d, 0x10, 0x77, 0x64, 0x5b, 0x2e, 0x41,
+	0x17, 0x61, 0xc9, 0x21, 0xee, 0xd1, 0xc0, 0xe3, 0x45, 0x86, 0xaf, 0xd0, 0x37, 0xa0, 0xe0, 0x3f,
+	0xe9, 0xd1, 0x62, 0xb3, 0xea, 0xef, 0x36, 0xfc, 0x4d, 0xaa, 0x04, 0xff, 0xd9, 0x1d, 0xc7, 0x98,
+	0xe4, 0xc9, 0x3c, 0xe6, 0xe8, 0x2d, 0xbe, 0xcb, 0x2c, 0xef, 0x18, 0x5d, 0x52, 0x4c, 0xc7, 0xb7,
+	0x3c, 0xfd, 0x3d, 0xf6, 0x61, 0xd2, 0x0f, 0x05, 0x28, 0x05, 0x2a, 0x28, 0x16, 0xcd, 0x09, 0x5d,
+	0xcf, 0xbc, 0x47, 0xce, 0xca, 0xb1, 0x33, 0x6c, 0x9c, 0x9c, 0x61, 0x63, 0xe9, 0x43, 0x78, 0x6e,
+	0xa6, 0x14, 0xdc, 0x9a, 0xcf, 0x41, 0xde, 0x27, 0xe1, 0xf1, 0x32, 0x29, 0x60, 0x3f, 0xeb, 0xb7,
+	0xed, 0x11, 0xfa, 0x7f, 0x58, 0x9e, 0xa6, 0x9f, 0xc1, 0xe0, 0x4e, 0x1c, 0xb8, 0x0e, 0x19, 0xe2,
+	0x38, 0xb6, 0xc3, 0x4d, 0xe8, 0x2f, 0xa4, 0xdf, 0xa4, 0x61, 0x3d, 0xe0, 0x59, 0x33, 0xbc, 0xee,
+	0x61, 0xa0, 0xf3, 0x3b, 0xb0, 0xc4, 0x84, 0x77, 0x8b, 0xc2, 0xa5, 0xd4, 0x5c, 0x4a, 0x73, 0xdc,
+	0xff, 0x76, 0x38, 0x1b, 0x90, 0x3b, 0x30, 0xad, 0x9e, 0x69, 0xf5, 0xdd, 0x62, 0x86, 0x29, 0x28,
+	0xc7, 0x49, 0x06, 0x33, 0x2c, 0xb5, 0x59, 0xe3, 0x74, 0x64, 0xcb, 0x73, 0x4e, 0xf0, 0x98, 0x6c,
+	0xf8, 0xc4, 0x2c, 0x45, 0x4e, 0x0c, 0xda, 0x0b, 0x67, 0xe5, 0x62, 0x76, 0x91, 0x5c, 0x17, 0x22,
+	0x50, 0xea, 0xc3, 0x6a, 0x44, 0x06, 0x24, 0x42, 0xea, 0x2e, 0x39, 0xe1, 0x89, 0x8f, 0x3e, 0xa2,
+	0x77, 0x82, 0x66, 0x2f, 0x39, 0x6f, 0x99, 0xe4, 0x8d, 0xe1, 0x1b, 0xc9, 0x6b, 0x82, 0xf4, 0x27,
+	0x01, 0x2e, 0x4c, 0x99, 0xe0, 0x2b, 0x76, 0xd0, 0x5f, 0x87, 0x3c, 0x5b, 0xb7, 0x3c, 0x32, 0xa2,
+	0x27, 0x62, 0x72, 0xac, 0xf3, 0xc1, 0x61, 0x5d, 0x87, 0x0c, 0x8b, 0x1e, 0x56, 0x23, 0xf2, 0xd8,
+	0x5f, 0x48, 0x26, 0x64, 0x18, 0x10, 0xd5, 0xe8, 0x6b, 0x32, 0x0a, 0x8e, 0xc5, 0x4b, 0x71, 0x25,
+	0xa0, 0x1c, 0xb1, 0x0f, 0x45, 0xcf, 0x43, 0xde, 0x73, 0x8e, 0xac, 0xae, 0xe1, 0x91, 0x1e, 0x33,
+	0x48, 0x0e, 0x4f, 0x36, 0xa4, 0xff, 0x24, 0xe1, 0xd9, 0xc0, 0xca, 0x21, 0x8f, 0xf3, 0x73, 0x59,
+	0x8e, 0xc4, 0x8e, 0x2f, 0x39, 0x90, 0x48, 0x39, 0xb7, 0x6c, 0xcf, 0xf0, 0x26, 0x87, 0x2e, 0x4e,
+	0x39, 0x57, 0x39, 0x04, 0x8f, 0xc1, 0xb3, 0x4e, 0x52, 0x6a, 0xd6, 0x49, 0xba, 0x13, 0x3a, 0x49,
+	0x69, 0x66, 0x93, 0x77, 0xe7, 0x38, 0x49, 0x0f, 0x29, 0xf8, 0xa8, 0xe3, 0xf4, 0xf4, 0xa2, 0xfc,
+	0x2a, 0x94, 0x66, 0x49, 0xf7, 0xe5, 0x91, 0x2e, 0x5d, 0x82, 0xa5, 0xba, 0x3d, 0x38, 0x1a, 0x5a,
+	0xf4, 0x17, 0x8c, 0x98, 0x1f, 0x22, 0x02, 0xe6, 0x2b, 0xe9, 0x6f, 0xa1, 0xc6, 0x61, 0x9f, 0x74,
+	0x69, 0xf3, 0x74, 0x66, 0xb9, 0x56, 0x87, 0x6c, 0x97, 0x71, 0x0f, 0x5a, 0x9b, 0x79, 0xb2, 0x59,
+	0x44, 0x98, 0x4d, 0x5f, 0x0b, 0x6e, 0xfe, 0x80, 0x6a, 0xdc, 0x68, 0x28, 0x11, 0x58, 0x09, 0x13,
+	0x98, 0xe1, 0xa4, 0xb7, 0xa3, 0x4e, 0xfa, 0x56, 0x9c, 0x96, 0x96, 0x51, 0x0c, 0xfb, 0xe8, 0x13,
+	0x01, 0x72, 0xd8, 0xfe, 0x48, 0xa6, 0x35, 0x8c, 0xf2, 0x70, 0xec, 0x8f, 0x18, 0x8f, 0x55, 0x4c,
+	0x1f, 0x69, 0xea, 0x1d, 0x12, 0xd7, 0x35, 0xfa, 0xc1, 0x6d, 0x30, 0x58, 0xa2, 0x9d, 0x70, 0x15,
+	0x5c, 0xae, 0x54, 0xe2, 0x9b, 0xc9, 0xb4, 0x2d, 0xc6, 0x2e, 0xa8, 0x9c, 0x1f, 0xc1, 0xc5, 0x69,
+	0x03, 0xf2, 0x10, 0x29, 0x42, 0xd6, 0x0f, 0x8a, 0x20, 0x02, 0x82, 0x25, 0xaa, 0xc3, 0x12, 0x03,
+	0x07, 0x5e, 0x8a, 0x75, 0x21, 0xe2, 0x6a, 0x62, 0x0e, 0x95, 0x30, 0x14, 0xea, 0xf6, 0x70, 0x64,
+	0x0e, 0xc8, 0x99, 0xc5, 0x8f, 0xf4, 0x5d, 0x58, 0x1b, 0xd3, 0xe4, 0x5a, 0xfc, 0x1f, 0xc0, 0xc8,
+	0xb1, 0xfb, 0x8e, 0x31, 0xd4, 0xcd, 0x1e, 0x77, 0x60, 0x9e, 0xef, 0x28, 0xbd, 0x59, 0xfd, 0xc6,
+	0x6a, 0xb8, 0xdf, 0x90, 0xfe, 0x9d, 0x84, 0x82, 0x7c, 0x4c, 0xba, 0x47, 0xde, 0x58, 0xce, 0xc7,
+	0x90, 0xfc, 0x5e, 0x28, 0x93, 0xf8, 0xf6, 0x79, 0x3b, 0x56, 0x51, 0x0c, 0xf3, 0x78, 0x64, 0x35,
+	0x8e, 0x74, 0x23, 0xa9, 0x33, 0xef, 0x46, 0xd2, 0xb3, 0xba, 0x91, 0xa7, 0x97, 0xb3, 0x4e, 0x60,
+	0x6d, 0x6c, 0x87, 0xa7, 0x5b, 0x92, 0xa5, 0x7f, 0x0a, 0xb0, 0xb6, 0x6f, 0x0c, 0xcc, 0x9e, 0xe1,
+	0x91, 0xa7, 0x5e, 0xa4, 0x26, 0x91, 0x9f, 0x5a, 0x30, 0x73, 0xc6, 0x6c, 0x18, 0xa5, 0x5f, 0x08,
+	0x70, 0x8e, 0xab, 0x69, 0xda, 0x56, 0xd3, 0xb1, 0x0f, 0x06, 0x64, 0x18, 0x4e, 0x34, 0xc2, 0x23,
+	0x12, 0x4d, 0xf2, 0x09, 0x13, 0x0d, 0x2a, 0x41, 0x6e, 0x64, 0xbb, 0xe6, 0xd8, 0x05, 0x19, 0x3c,
+	0x5e, 0x4b, 0xbf, 0x12, 0x40, 0x9c, 0x18, 0x9f, 0x7b, 0xde, 0x9f, 0xec, 0xf1, 0x13, 0x96, 0xc3,
+	0xfe, 0x02, 0x35, 0x21, 0x37, 0xf2, 0xa5, 0x0e, 0x4e, 0xd7, 0xd5, 0x58, 0x33, 0x8e, 0x29, 0x95,
+	0xf1, 0x98, 0x4a, 0xdc, 0x9a, 0x20, 0x7d, 0x9a, 0xa4, 0x19, 0xcb, 0xba, 0x47, 0x1c, 0xef, 0x2b,
+	0x18, 0x20, 0x6d, 0x58, 0xf3, 0x0c, 0xa7, 0x4f, 0x3c, 0x7d, 0x2c, 0x51, 0x7a, 0x7e, 0x89, 0x0a,
+	0x3e, 0x8d, 0x60, 0x2d, 0xb9, 0xb0, 0x36, 0x36, 0x09, 0x77, 0xdb, 0xe3, 0x6c, 0x32, 0x51, 0x25,
+	0xb9, 0x60, 0x96, 0xff, 0xbd, 0x00, 0xeb, 0x75, 0x87, 0xd0, 0x7b, 0x7a, 0xb4, 0xa9, 0x8c, 0x24,
+	0x47, 0xe1, 0xcc, 0x93, 0x63, 0x32, 0xee, 0x55, 0x6d, 0x66, 0xf8, 0xfc, 0x45, 0x80, 0x2c, 0x97,
+	0x17, 0x15, 0x20, 0x39, 0xae, 0x1c, 0x49, 0xb3, 0x37, 0xbb, 0x59, 0x47, 0x37, 0x21, 0x1f, 0x8c,
+	0x0e, 0x03, 0xbf, 0x5f, 0x8f, 0x33, 0x0e, 0xf5, 0x99, 0x8c, 0x47, 0x90, 0xbc, 0x86, 0x4c, 0x68,
+	0x95, 0xde, 0x84, 0x42, 0xf4, 0xe5, 0x8c, 0x84, 0xbe, 0x1e, 0x4e, 0xe8, 0xf9, 0x70, 0x92, 0xfe,
+	0x00, 0x2e, 0x4c, 0x59, 0x9f, 0x7b, 0xbe, 0x01, 0x59, 0x37, 0xe4, 0xf6, 0x78, 0x55, 0x20, 0x20,
+	0x12, 0x40, 0x25, 0x0b, 0x96, 0x9b, 0x47, 0xee, 0x61, 0xa8, 0xd8, 0xf2, 0x37, 0xa1, 0x62, 0xcb,
+	0x77, 0x94, 0xde, 0x19, 0x44, 0x53, 0x1b, 0x56, 0x7c, 0x7e, 0x67, 0xaa, 0x45, 0x05, 0xce, 0x6d,
+	0x13, 0x6f, 0x2a, 0x3e, 0xbf, 0x5c, 0x17, 0xe9, 0x7d, 0x40, 0x61, 0xcc, 0x99, 0xca, 0xf3, 0x2a,
+	0xac, 0x37, 0xc8, 0x80, 0x78, 0x64, 0x3e, 0x91, 0x9e, 0x81, 0x0b, 0x53, 0x30, 0x5f, 0x2a, 0xe9,
+	0xf3, 0x14, 0xac, 0xec, 0x98, 0xae, 0x67, 0x3b, 0x27, 0x7e, 0x04, 0x4d, 0x42, 0x3a, 0xcd, 0x42,
+	0xfa, 0xeb, 0x50, 0xf0, 0xcc, 0x21, 0xd1, 0x8f, 0x2c, 0xf3, 0x58, 0xb7, 0x0c, 0xcb, 0x66, 0x81,
+	0x94, 0xc2, 0x2b, 0x74, 0xb7, 0x63, 0x99, 0xc7, 0xaa, 0x61, 0xd9, 0xb4, 0xba, 0x0f, 0x89, 0x77,
+	0x68, 0xf7, 0x78, 0x55, 0xe6, 0x2b, 0xba, 0x4f, 0x07, 0xbf, 0xc4, 0x61, 0x49, 0x2a, 0x8f, 0xf9,
+	0x2a, 0xe4, 0xee, 0xcc, 0x82, 0x79, 0x30, 0x3a, 0xb4, 0x58, 0x7a, 0xc2, 0xa1, 0x45, 0x34, 0xe5,
+	0x64, 0x9f, 0x2c, 0xe5, 0x4c, 0x5a, 0x9d, 0xdc, 0x63, 0x5a, 0x9d, 0xfc, 0xac, 0x56, 0x07, 0x41,
+	0xba, 0x6b, 0xf7, 0x48, 0x11, 0x58, 0xf6, 0x61, 0xcf, 0x93, 0x69, 0xd9, 0x72, 0x68, 0x5a, 0x16,
+	0xbd, 0xb8, 0xaf, 0x4c, 0x5f, 0xdc, 0x7f, 0x2b, 0x00, 0xda, 0x35, 0x5d, 0x8f, 0xbb, 0x37, 0x88,
+	0x94, 0x2b, 0xb0, 0xee, 0x7a, 0x86, 0xe3, 0xe9, 0x53, 0x6e, 0x15, 0x98, 0x5b, 0xcf, 0xb1, 0x77,
+	0xed, 0xb0, 0x6f, 0x5f, 0x04, 0x44, 0xac, 0x9e, 0x3e, 0x33, 0x0a, 0xd6, 0x88, 0xd5, 0x8b, 0xfc,
+	0xf8, 0x39, 0xc8, 0x8f, 0x8c, 0x3e, 0xd1, 0x5d, 0xf3, 0x63, 0xc2, 0xf3, 0x67, 0x8e, 0x6e, 0xb4,
+	0xcc, 0x8f, 0xfd, 0x1e, 0x9e, 0xbe, 0xf4, 0xc7, 0x1c, 0x69, 0xde, 0x70, 0x1b, 0x7d, 0xc2, 0x5c,
+	0x2b, 0xfd, 0x44, 0x80, 0xf3, 0x11, 0x81, 0xf9, 0xc9, 0x51, 0x20, 0x4b, 0x2c, 0xcf, 0x31, 0x49,
+	0x70, 0xa1, 0xb8, 0x12, 0xc3, 0x33, 0xe1, 0xa0, 0xc6, 0x01, 0x9e, 0x26, 0x79, 0x8b, 0x1c, 0x7b,
+	0x7a, 0x48, 0x0c, 0xde, 0x6e, 0xd2, 0xed, 0xe6, 0x58, 0x94, 0x1a, 0x40, 0xd3, 0x70, 0x5c, 0x22,
+	0x3f, 0xd4, 0xf2, 0x08, 0xd1, 0x96, 0xe7, 0xd1, 0x77, 0x3b, 0xe9, 0x77, 0x02, 0xac, 0x4d, 0xf5,
+	0x50, 0x68, 0x8b, 0x86, 0x86, 0xe1, 0x8e, 0xcb, 0xda, 0x66, 0x9c, 0x88, 0xa5, 0x48, 0xcc, 0x50,
+	0x98, 0xa3, 0xe9, 0x75, 0x87, 0x49, 0xaf, 0x9b, 0x56, 0x8f, 0x1c, 0x07, 0xe3, 0x55, 0xb6, 0xa5,
+	0xd0, 0x9d, 0xc9, 0x30, 0x29, 0x15, 0x1e, 0x26, 0x4d, 0xdd, 0x92, 0xd2, 0xd3, 0x53, 0x59, 0x69,
+	0x03, 0xd0, 0xa4, 0xd4, 0xc9, 0xc7, 0x5d, 0x42, 0x7a, 0x84, 0x95, 0xb5, 0x81, 0x39, 0x34, 0x3d,
+	0x7e, 0xa7, 0xf5, 0x17, 0x1b, 0x77, 0x20, 0x17, 0x7c, 0x76, 0x41, 0xab, 0x90, 0xef, 0xa8, 0x0d,
+	0x79, 0x4b, 0x51, 0xe5, 0x86, 0x98, 0x40, 0x59, 0x48, 0x55, 0x1b, 0x0d, 0x51, 0x40, 0x2b, 0x90,
+	0x6b, 0x75, 0x6a, 0x6d, 0x5c, 0xad, 0xb7, 0xc5, 0x24, 0x5d, 0xed, 0x75, 0x76, 0xdb, 0x4a, 0x73,
+	0xf7, 0xb6, 0x98, 0x42, 0x00, 0x4b, 0x0d, 0x65, 0x5f, 0x69, 0xc8, 0x62, 0x9a, 0x02, 0x9a, 0xda,
+	0x4d, 0x31, 0x43, 0x1f, 0xf6, 0xb4, 0x86, 0xb8, 0x84, 0x72, 0x90, 0x56, 0x1a, 0xca, 0xbe, 0x98,
+	0xdd, 0x28, 0x43, 0x2e, 0xe8, 0x53, 0xe8, 0x6b, 0xdc, 0x54, 0xc5, 0x04, 0xca, 0x43, 0x46, 0x51,
+	0xb7, 0x94, 0x5b, 0xa2, 0xb0, 0x81, 0x21, 0xcb, 0xbf, 0x1c, 0xa2, 0x8b, 0x80, 0x5a, 0xed, 0x6a,
+	0xfd, 0x3d, 0x5d, 0x6b, 0xea, 0x53, 0xf2, 0x34, 0x3a, 0x4d, 0x51, 0xa0, 0x54, 0x5b, 0x37, 0xab,
+	0x4d, 0x31, 0x49, 0x9f, 0x1a, 0x58, 0x6b, 0x8a, 0x29, 0x46, 0x53, 0x6b, 0x8b, 0x69, 0x4a, 0xb3,
+	0xbe, 0x2b, 0x57, 0xb1, 0x98, 0xd9, 0xb8, 0x0e, 0x30, 0xf9, 0x9c, 0x8b, 0x9e, 0x85, 0x0b, 0x58,
+	0xde, 0x56, 0x5a, 0x6d, 0x19, 0xcf, 0xa0, 0xdc, 0x6a, 0x6b, 0xa2, 0xc0, 0xa8, 0xd4, 0x77, 0xc5,
+	0xe4, 0x46, 0x05, 0xf2, 0x93, 0xa6, 0x62, 0x19, 0xb2, 0x5b, 0xbb, 0x5a, 0xb5, 0xfd, 0xda, 0x55,
+	0x31, 0x41, 0x8d, 0x54, 0x53, 0xb6, 0x75, 0xb6, 0x21, 0x0a, 0x94, 0x9d, 0x7c, 0x8b, 0x19, 0x66,
+	0xe3, 0x8f, 0x49, 0x58, 0x0e, 0xb9, 0x19, 0x3d, 0x0f, 0x45, 0x19, 0x63, 0x0d, 0xeb, 0x58, 0xae,
+	0xb6, 0x34, 0x55, 0xef, 0xa8, 0xad, 0xa6, 0x5c, 0x57, 0xb6, 0x14, 0xc6, 0xf3, 0x19, 0x38, 0xaf,
+	0x6a, 0x6d, 0x5d, 0x56, 0xb5, 0xce, 0xf6, 0x8e, 0xae, 0x35, 0x65, 0x5c, 0x55, 0x1b, 0x2d, 0x51,
+	0x40, 0x05, 0x00, 0x5f, 0xfd, 0xad, 0xce, 0xee, 0xae, 0x98, 0xa4, 0x72, 0x2b, 0x6a, 0x5d, 0xdb,
+	0x6b, 0xee, 0xca, 0x6d, 0x59, 0x97, 0x6f, 0x35, 0xb1, 0xdc, 0x6a, 0x29, 0x9a, 0x2a, 0xa6, 0xd0,
+	0x3a, 0x88, 0xd4, 0xf8, 0x74, 0xa5, 0xd7, 0x6e, 0xeb, 0xef, 0xcb, 0x58, 0x13, 0xd3, 0x48, 0x84,
+	0x95, 0x86, 0xb6, 0x57, 0x55, 0x54, 0x9d, 0xb1, 0x17, 0x33, 0x74, 0x47, 0xeb, 0xb4, 0x75, 0x6d,
+	0x4b, 0xc7, 0x55, 0x75, 0x5b, 0x16, 0x97, 0xd0, 0x05, 0x38, 0xd7, 0x51, 0xdf, 0x53, 0xb5, 0x9b,
+	0xaa, 0xcf, 0xba, 0x4d, 0x09, 0x66, 0xd1, 0x1a, 0x2c, 0xdf, 0xc4, 0x9a, 0xba, 0xad, 0x57, 0xb1,
+	0xd2, 0xbe, 0x2d, 0xe6, 0xd0, 0x79, 0x58, 0x53, 0xd4, 0xfd, 0xea, 0xae, 0xd2, 0x08, 0x44, 0x14,
+	0xf3, 0x54, 0xa2, 0x8e, 0xda, 0xea, 0x34, 0x9b, 0x1a, 0x6e, 0xcb, 0x8d, 0x10, 0x01, 0xa0, 0x12,
+	0x75, 0xd4, 0x9a, 0xd6, 0x51, 0x1b, 0xfa, 0x7e, 0x15, 0x2b, 0xd5, 0xda, 0xae, 0x2c, 0x2e, 0x53,
+	0xfe, 0xad, 0xdb, 0x6a, 0xbb, 0x7a, 0x8b, 0x4b, 0xb4, 0x82, 0x10, 0x14, 0xe4, 0xbd, 0x66, 0xfb,
+	0xb6, 0x1e, 0xb8, 0x44, 0x5c, 0xad, 0xfc, 0xb4, 0x00, 0x50, 0x1f, 0x1f, 0x1a, 0xf4, 0x63, 0x01,
+	0x0a, 0xd1, 0x4f, 0x40, 0xe8, 0xda, 0x1c, 0x03, 0xa8, 0xc8, 0x67, 0xb4, 0xd2, 0xf5, 0x05, 0x90,
+	0x7e, 0xe2, 0xba, 0x2c, 0xa0, 0x5f, 0x0a, 0x70, 0x7e, 0xc6, 0x37, 0x14, 0x74, 0x63, 0x0e, 0xa2,
+	0x0f, 0x7f, 0x01, 0x2a, 0xbd, 0xb5, 0x28, 0x3c, 0x10, 0xec, 0x3b, 0x02, 0xfa, 0x81, 0x00, 0xab,
+	0x91, 0xe9, 0x39, 0x7a, 0x7d, 0xc1, 0x4f, 0x0e, 0xa5, 0x6b, 0xf3, 0x03, 0x79, 0x6a, 0xff, 0x54,
+	0x00, 0xf4, 0xf0, 0x74, 0x13, 0xbd, 0xf9, 0x24, 0x23, 0xdb, 0xd2, 0x8d, 0x05, 0xd1, 0x5c, 0xa6,
+	0x1f, 0x85, 0xa2, 0xc7, 0x1f, 0xa5, 0xcd, 0x15, 0x3d, 0x91, 0xf1, 0x65, 0xe9, 0xfa, 0x02, 0x48,
+	0x2e, 0xc7, 0x08, 0xb2, 0x7c, 0x08, 0x86, 0x5e, 0x8e, 0x35, 0x95, 0x0c, 0x0f, 0xe1, 0x4a, 0x95,
+	0x79, 0x20, 0x13, 0x8e, 0x7c, 0x6c, 0x13, 0x8b, 0x63, 0x74, 0xd4, 0x55, 0xaa, 0xcc, 0x03, 0xe1,
+	0x1c, 0x5d, 0xc8, 0xf1, 0x2b, 0x3d, 0x41, 0x95, 0xf8, 0xf7, 0xff, 0x31, 0xcf, 0x57, 0xe6, 0xc2,
+	0x84, 0x0d, 0xcb, 0x2e, 0xbb, 0x31, 0x0d, 0x1b, 0x9e, 0x15, 0x94, 0x2a, 0xf3, 0x40, 0x38, 0x47,
+	0x7a, 0xd6, 0x22, 0x77, 0xad, 0x58, 0x67, 0x6d, 0xd6, 0xdd, 0xb8, 0x74, 0x6d, 0x7e, 0x20, 0x17,
+	0xa2, 0x0f, 0x69, 0x7a, 0x41, 0x42, 0x71, 0x7a, 0x8e, 0xd0, 0xcd, 0xad, 0x74, 0x25, 0xf6, 0xef,
+	0x39, 0xa3, 0x13, 0x80, 0xc9, 0xfd, 0x07, 0xc5, 0x19, 0xeb, 0x3c, 0x74, 0xc5, 0x2a, 0xbd, 0x3a,
+	0x27, 0x2a, 0x64, 0xe8, 0xc8, 0x45, 0x27, 0x96, 0xa1, 0x67, 0xdd, 0xa8, 0x4a, 0xd7, 0xe6, 0x07,
+	0x72, 0x21, 0xbe, 0x0f, 0xcb, 0xa1, 0x36, 0x16, 0xc5, 0x51, 0xe5, 0xe1, 0x3e, 0xbd, 0xf4, 0xda,
+	0xbc, 0x30, 0x9f, 0x7b, 0xed, 0x8d, 0xcf, 0xee, 0x97, 0x13, 0x9f, 0xdf, 0x2f, 0x27, 0xbe, 0xb8,
+	0x5f, 0x16, 0x3e, 0x39, 0x2d, 0x0b, 0xbf, 0x3e, 0x2d, 0x0b, 0x7f, 0x3d, 0x2d, 0x0b, 0x9f, 0x9d,
+	0x96, 0x85, 0x7f, 0x9c, 0x96, 0x85, 0x7f, 0x9d, 0x96, 0x13, 0x5f, 0x9c, 0x96, 0x85, 0x9f, 0x3f,
+	0x28, 0x27, 0x3e, 0x7b, 0x50, 0x4e, 0x7c, 0xfe, 0xa0, 0x9c, 0x78, 0x3f, 0x4d, 0xff, 0xc3, 0x77,
+	0xb0, 0xc4, 0xfe, 0xb9, 0xf7, 0xca, 0x7f, 0x07, 0x00, 0x4f, 0x34, 0x6c, 0x80, 0xd6, 0x27, 0x00,
+	0x00,
+}
+
+func (x Operator) String() string {
+	s, ok := Operator_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Notation) String() string {
+	s, ok := Notation_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x StackOp) String() string {
+	s, ok := StackOp_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RegisterOp) String() string {
+	s, ok := RegisterOp_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Precision) String() string {
+	s, ok := Precision_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ErrorReason) String() string {
+	s, ok := ErrorReason_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Operand) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Operand)
+	if !ok {
+		that2, ok := that.(Operand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Decimal != that1.Decimal {
+		return false
+	}
+	return true
+}
+func (this *Function) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Function)
+	if !ok {
+		that2, ok := that.(Function)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Arity != that1.Arity {
+		return false
+	}
+	return true
+}
+func (this *Variable) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Variable)
+	if !ok {
+		that2, ok := that.(Variable)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *Register) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Register)
+	if !ok {
+		that2, ok := that.(Register)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Op != that1.Op {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *Token) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Token)
+	if !ok {
+		that2, ok := that.(Token)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Token == nil {
+		if this.Token != nil {
+			return false
+		}
+	} else if this.Token == nil {
+		return false
+	} else if !this.Token.Equal(that1.Token) {
+		return false
+	}
+	return true
+}
+func (this *Token_Operand) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Token_Operand)
+	if !ok {
+		that2, ok := that.(Token_Operand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Operand.Equal(that1.Operand) {
+		return false
+	}
+	return true
+}
+func (this *Token_Operator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Token_Operator)
+	if !ok {
+		that2, ok := that.(Token_Operator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	return true
+}
+func (this *Token_Function) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Token_Function)
+	if !ok {
+		that2, ok := that.(Token_Function)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Function.Equal(that1.Function) {
+		return false
+	}
+	return true
+}
+func (this *Token_StackOp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Token_StackOp)
+	if !ok {
+		that2, ok := that.(Token_StackOp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StackOp != that1.StackOp {
+		return false
+	}
+	return true
+}
+func (this *Token_Variable) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Token_Variable)
+	if !ok {
+		that2, ok := that.(Token_Variable)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Variable.Equal(that1.Variable) {
+		return false
+	}
+	return true
+}
+func (this *Token_Register) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Token_Register)
+	if !ok {
+		that2, ok := that.(Token_Register)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Register.Equal(that1.Register) {
+		return false
+	}
+	return true
+}
+func (this *Expression) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Expression)
+	if !ok {
+		that2, ok := that.(Expression)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Expression == nil {
+		if this.Expression != nil {
+			return false
+		}
+	} else if this.Expression == nil {
+		return false
+	} else if !this.Expression.Equal(that1.Expression) {
+		return false
+	}
+	return true
+}
+func (this *Expression_Literal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Expression_Literal)
+	if !ok {
+		that2, ok := that.(Expression_Literal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Literal.Equal(that1.Literal) {
+		return false
+	}
+	return true
+}
+func (this *Expression_Variable) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Expression_Variable)
+	if !ok {
+		that2, ok := that.(Expression_Variable)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Variable.Equal(that1.Variable) {
+		return false
+	}
+	return true
+}
+func (this *Expression_Binary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Expression_Binary)
+	if !ok {
+		that2, ok := that.(Expression_Binary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Binary.Equal(that1.Binary) {
+		return false
+	}
+	return true
+}
+func (this *Expression_Call) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Expression_Call)
+	if !ok {
+		that2, ok := that.(Expression_Call)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Call.Equal(that1.Call) {
+		return false
+	}
+	return true
+}
+func (this *BinaryExpression) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BinaryExpression)
+	if !ok {
+		that2, ok := that.(BinaryExpression)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if !this.Left.Equal(that1.Left) {
+		return false
+	}
+	if !this.Right.Equal(that1.Right) {
+		return false
+	}
+	return true
+}
+func (this *Call) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Call)
+	if !ok {
+		that2, ok := that.(Call)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Args) != len(that1.Args) {
+		return false
+	}
+	for i := range this.Args {
+		if !this.Args[i].Equal(that1.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EvaluateStreamRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateStreamRequest)
+	if !ok {
+		that2, ok := that.(EvaluateStreamRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Token.Equal(that1.Token) {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.FloatPrecision != that1.FloatPrecision {
+		return false
+	}
+	if this.MaxStackDepth != that1.MaxStackDepth {
+		return false
+	}
+	if this.Explain != that1.Explain {
+		return false
+	}
+	return true
+}
+func (this *EvaluateStreamResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateStreamResponse)
+	if !ok {
+		that2, ok := that.(EvaluateStreamResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if this.ResultDecimal != that1.ResultDecimal {
+		return false
+	}
+	if this.ResultFraction != that1.ResultFraction {
+		return false
+	}
+	if !this.Trace.Equal(that1.Trace) {
+		return false
+	}
+	return true
+}
+func (this *EvaluateInteractiveRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateInteractiveRequest)
+	if !ok {
+		that2, ok := that.(EvaluateInteractiveRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Token.Equal(that1.Token) {
+		return false
+	}
+	if this.MaxStackDepth != that1.MaxStackDepth {
+		return false
+	}
+	return true
+}
+func (this *EvaluateInteractiveResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateInteractiveResponse)
+	if !ok {
+		that2, ok := that.(EvaluateInteractiveResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StackTop != that1.StackTop {
+		return false
+	}
+	if this.StackDepth != that1.StackDepth {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *EvaluateBatchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateBatchRequest)
+	if !ok {
+		that2, ok := that.(EvaluateBatchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.FloatPrecision != that1.FloatPrecision {
+		return false
+	}
+	if this.MaxStackDepth != that1.MaxStackDepth {
+		return false
+	}
+	if len(this.Bindings) != len(that1.Bindings) {
+		return false
+	}
+	for i := range this.Bindings {
+		if !this.Bindings[i].Equal(that1.Bindings[i]) {
+			return false
+		}
+	}
+	if this.Explain != that1.Explain {
+		return false
+	}
+	if !this.Expression.Equal(that1.Expression) {
+		return false
+	}
+	return true
+}
+func (this *EvaluateBatchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateBatchResponse)
+	if !ok {
+		that2, ok := that.(EvaluateBatchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if this.ResultDecimal != that1.ResultDecimal {
+		return false
+	}
+	if this.ResultFraction != that1.ResultFraction {
+		return false
+	}
+	if !this.Trace.Equal(that1.Trace) {
+		return false
+	}
+	return true
+}
+func (this *TraceStep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TraceStep)
+	if !ok {
+		that2, ok := that.(TraceStep)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if len(this.Stack) != len(that1.Stack) {
+		return false
+	}
+	for i := range this.Stack {
+		if this.Stack[i] != that1.Stack[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *Trace) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Trace)
+	if !ok {
+		that2, ok := that.(Trace)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Steps) != len(that1.Steps) {
+		return false
+	}
+	for i := range this.Steps {
+		if !this.Steps[i].Equal(that1.Steps[i]) {
+			return false
+		}
+	}
+	if this.Truncated != that1.Truncated {
+		return false
+	}
+	return true
+}
+func (this *EvaluateExpressionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateExpressionRequest)
+	if !ok {
+		that2, ok := that.(EvaluateExpressionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Expression != that1.Expression {
+		return false
+	}
+	if this.Notation != that1.Notation {
+		return false
+	}
+	if this.MaxStackDepth != that1.MaxStackDepth {
+		return false
+	}
+	if len(this.Bindings) != len(that1.Bindings) {
+		return false
+	}
+	for i := range this.Bindings {
+		if !this.Bindings[i].Equal(that1.Bindings[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EvaluateExpressionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateExpressionResponse)
+	if !ok {
+		that2, ok := that.(EvaluateExpressionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	return true
+}
+func (this *Column) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Column)
+	if !ok {
+		that2, ok := that.(Column)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *EvaluateVectorRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateVectorRequest)
+	if !ok {
+		that2, ok := that.(EvaluateVectorRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if len(this.Columns) != len(that1.Columns) {
+		return false
+	}
+	for i := range this.Columns {
+		if !this.Columns[i].Equal(that1.Columns[i]) {
+			return false
+		}
+	}
+	if this.MaxStackDepth != that1.MaxStackDepth {
+		return false
+	}
+	return true
+}
+func (this *RowError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RowError)
+	if !ok {
+		that2, ok := that.(RowError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Row != that1.Row {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *EvaluateVectorResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluateVectorResponse)
+	if !ok {
+		that2, ok := that.(EvaluateVectorResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if this.Results[i] != that1.Results[i] {
+			return false
+		}
+	}
+	if len(this.Errors) != len(that1.Errors) {
+		return false
+	}
+	for i := range this.Errors {
+		if !this.Errors[i].Equal(that1.Errors[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CompileRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompileRequest)
+	if !ok {
+		that2, ok := that.(CompileRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CompileResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompileResponse)
+	if !ok {
+		that2, ok := that.(CompileResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProgramId != that1.ProgramId {
+		return false
+	}
+	if this.StackDepth != that1.StackDepth {
+		return false
+	}
+	return true
+}
+func (this *ExecuteRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecuteRequest)
+	if !ok {
+		that2, ok := that.(ExecuteRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProgramId != that1.ProgramId {
+		return false
+	}
+	if len(this.Bindings) != len(that1.Bindings) {
+		return false
+	}
+	for i := range this.Bindings {
+		if !this.Bindings[i].Equal(that1.Bindings[i]) {
+			return false
+		}
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.FloatPrecision != that1.FloatPrecision {
+		return false
+	}
+	return true
+}
+func (this *ExecuteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecuteResponse)
+	if !ok {
+		that2, ok := that.(ExecuteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if this.ResultDecimal != that1.ResultDecimal {
+		return false
+	}
+	if this.ResultFraction != that1.ResultFraction {
+		return false
+	}
+	return true
+}
+func (this *ValidateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidateRequest)
+	if !ok {
+		that2, ok := that.(ValidateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Expression != that1.Expression {
+		return false
+	}
+	if this.Notation != that1.Notation {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if this.MaxStackDepth != that1.MaxStackDepth {
+		return false
+	}
+	return true
+}
+func (this *ValidationProblem) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidationProblem)
+	if !ok {
+		that2, ok := that.(ValidationProblem)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if this.Position != that1.Position {
+		return false
+	}
+	return true
+}
+func (this *ValidateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidateResponse)
+	if !ok {
+		that2, ok := that.(ValidateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Valid != that1.Valid {
+		return false
+	}
+	if len(this.Problems) != len(that1.Problems) {
+		return false
+	}
+	for i := range this.Problems {
+		if !this.Problems[i].Equal(that1.Problems[i]) {
+			return false
+		}
+	}
+	if this.MaxStackDepth != that1.MaxStackDepth {
+		return false
+	}
+	return true
+}
+func (this *ConvertRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConvertRequest)
+	if !ok {
+		that2, ok := that.(ConvertRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Expression != that1.Expression {
+		return false
+	}
+	if this.Notation != that1.Notation {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if this.TargetNotation != that1.TargetNotation {
+		return false
+	}
+	return true
+}
+func (this *ConvertResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConvertResponse)
+	if !ok {
+		that2, ok := that.(ConvertResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Expression != that1.Expression {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CreateSessionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateSessionRequest)
+	if !ok {
+		that2, ok := that.(CreateSessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.FloatPrecision != that1.FloatPrecision {
+		return false
+	}
+	if this.MaxStackDepth != that1.MaxStackDepth {
+		return false
+	}
+	return true
+}
+func (this *Session) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Session)
+	if !ok {
+		that2, ok := that.(Session)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if len(this.Stack) != len(that1.Stack) {
+		return false
+	}
+	for i := range this.Stack {
+		if this.Stack[i] != that1.Stack[i] {
+			return false
+		}
+	}
+	if len(this.Registers) != len(that1.Registers) {
+		return false
+	}
+	for i := range this.Registers {
+		if this.Registers[i] != that1.Registers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *CreateSessionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateSessionResponse)
+	if !ok {
+		that2, ok := that.(CreateSessionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Session.Equal(that1.Session) {
+		return false
+	}
+	return true
+}
+func (this *PushRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PushRequest)
+	if !ok {
+		that2, ok := that.(PushRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionId != that1.SessionId {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PushResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PushResponse)
+	if !ok {
+		that2, ok := that.(PushResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Session.Equal(that1.Session) {
+		return false
+	}
+	return true
+}
+func (this *GetSessionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetSessionRequest)
+	if !ok {
+		that2, ok := that.(GetSessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionId != that1.SessionId {
+		return false
+	}
+	return true
+}
+func (this *GetSessionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetSessionResponse)
+	if !ok {
+		that2, ok := that.(GetSessionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Session.Equal(that1.Session) {
+		return false
+	}
+	return true
+}
+func (this *DeleteSessionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteSessionRequest)
+	if !ok {
+		that2, ok := that.(DeleteSessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionId != that1.SessionId {
+		return false
+	}
+	return true
+}
+func (this *DeleteSessionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteSessionResponse)
+	if !ok {
+		that2, ok := that.(DeleteSessionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *HistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryEntry)
+	if !ok {
+		that2, ok := that.(HistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.TimeUnixNano != that1.TimeUnixNano {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.Caller != that1.Caller {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if !this.Expression.Equal(that1.Expression) {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if this.ResultDecimal != that1.ResultDecimal {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.Truncated != that1.Truncated {
+		return false
+	}
+	return true
+}
+func (this *ListHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListHistoryRequest)
+	if !ok {
+		that2, ok := that.(ListHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartTimeUnixNano != that1.StartTimeUnixNano {
+		return false
+	}
+	if this.EndTimeUnixNano != that1.EndTimeUnixNano {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
+	return true
+}
+func (this *ListHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListHistoryResponse)
+	if !ok {
+		that2, ok := that.(ListHistoryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
+	return true
+}
+func (this *ParseError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParseError)
+	if !ok {
+		that2, ok := that.(ParseError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Position != that1.Position {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	return true
+}
+func (this *EvaluationError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvaluationError)
+	if !ok {
+		that2, ok := that.(EvaluationError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.TokenIndex != that1.TokenIndex {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if this.StackDepth != that1.StackDepth {
+		return false
+	}
+	return true
+}
+func (this *StackDepthExceeded) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StackDepthExceeded)
+	if !ok {
+		that2, ok := that.(StackDepthExceeded)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *Operand) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.Operand{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Decimal: "+fmt.Sprintf("%#v", this.Decimal)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Function) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.Function{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Arity: "+fmt.Sprintf("%#v", this.Arity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Variable) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.Variable{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Register) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.Register{")
+	s = append(s, "Op: "+fmt.Sprintf("%#v", this.Op)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Token) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&v1pb.Token{")
+	if this.Token != nil {
+		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Token_Operand) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Token_Operand{` +
+		`Operand:` + fmt.Sprintf("%#v", this.Operand) + `}`}, ", ")
+	return s
+}
+func (this *Token_Operator) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Token_Operator{` +
+		`Operator:` + fmt.Sprintf("%#v", this.Operator) + `}`}, ", ")
+	return s
+}
+func (this *Token_Function) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Token_Function{` +
+		`Function:` + fmt.Sprintf("%#v", this.Function) + `}`}, ", ")
+	return s
+}
+func (this *Token_StackOp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Token_StackOp{` +
+		`StackOp:` + fmt.Sprintf("%#v", this.StackOp) + `}`}, ", ")
+	return s
+}
+func (this *Token_Variable) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Token_Variable{` +
+		`Variable:` + fmt.Sprintf("%#v", this.Variable) + `}`}, ", ")
+	return s
+}
+func (this *Token_Register) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Token_Register{` +
+		`Register:` + fmt.Sprintf("%#v", this.Register) + `}`}, ", ")
+	return s
+}
+func (this *Expression) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.Expression{")
+	if this.Expression != nil {
+		s = append(s, "Expression: "+fmt.Sprintf("%#v", this.Expression)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Expression_Literal) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Expression_Literal{` +
+		`Literal:` + fmt.Sprintf("%#v", this.Literal) + `}`}, ", ")
+	return s
+}
+func (this *Expression_Variable) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Expression_Variable{` +
+		`Variable:` + fmt.Sprintf("%#v", this.Variable) + `}`}, ", ")
+	return s
+}
+func (this *Expression_Binary) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Expression_Binary{` +
+		`Binary:` + fmt.Sprintf("%#v", this.Binary) + `}`}, ", ")
+	return s
+}
+func (this *Expression_Call) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&v1pb.Expression_Call{` +
+		`Call:` + fmt.Sprintf("%#v", this.Call) + `}`}, ", ")
+	return s
+}
+func (this *BinaryExpression) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.BinaryExpression{")
+	s = append(s, "Operator: "+fmt.Sprintf("%#v", this.Operator)+",\n")
+	if this.Left != nil {
+		s = append(s, "Left: "+fmt.Sprintf("%#v", this.Left)+",\n")
+	}
+	if this.Right != nil {
+		s = append(s, "Right: "+fmt.Sprintf("%#v", this.Right)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Call) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.Call{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.Args != nil {
+		s = append(s, "Args: "+fmt.Sprintf("%#v", this.Args)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateStreamRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&v1pb.EvaluateStreamRequest{")
+	if this.Token != nil {
+		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	}
+	s = append(s, "Precision: "+fmt.Sprintf("%#v", this.Precision)+",\n")
+	s = append(s, "FloatPrecision: "+fmt.Sprintf("%#v", this.FloatPrecision)+",\n")
+	s = append(s, "MaxStackDepth: "+fmt.Sprintf("%#v", this.MaxStackDepth)+",\n")
+	s = append(s, "Explain: "+fmt.Sprintf("%#v", this.Explain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateStreamResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.EvaluateStreamResponse{")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "ResultDecimal: "+fmt.Sprintf("%#v", this.ResultDecimal)+",\n")
+	s = append(s, "ResultFraction: "+fmt.Sprintf("%#v", this.ResultFraction)+",\n")
+	if this.Trace != nil {
+		s = append(s, "Trace: "+fmt.Sprintf("%#v", this.Trace)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateInteractiveRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.EvaluateInteractiveRequest{")
+	if this.Token != nil {
+		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	}
+	s = append(s, "MaxStackDepth: "+fmt.Sprintf("%#v", this.MaxStackDepth)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateInteractiveResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.EvaluateInteractiveResponse{")
+	s = append(s, "StackTop: "+fmt.Sprintf("%#v", this.StackTop)+",\n")
+	s = append(s, "StackDepth: "+fmt.Sprintf("%#v", this.StackDepth)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateBatchRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&v1pb.EvaluateBatchRequest{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "Precision: "+fmt.Sprintf("%#v", this.Precision)+",\n")
+	s = append(s, "FloatPrecision: "+fmt.Sprintf("%#v", this.FloatPrecision)+",\n")
+	s = append(s, "MaxStackDepth: "+fmt.Sprintf("%#v", this.MaxStackDepth)+",\n")
+	keysForBindings := make([]string, 0, len(this.Bindings))
+	for k, _ := range this.Bindings {
+		keysForBindings = append(keysForBindings, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBindings)
+	mapStringForBindings := "map[string]*Operand{"
+	for _, k := range keysForBindings {
+		mapStringForBindings += fmt.Sprintf("%#v: %#v,", k, this.Bindings[k])
+	}
+	mapStringForBindings += "}"
+	if this.Bindings != nil {
+		s = append(s, "Bindings: "+mapStringForBindings+",\n")
+	}
+	s = append(s, "Explain: "+fmt.Sprintf("%#v", this.Explain)+",\n")
+	if this.Expression != nil {
+		s = append(s, "Expression: "+fmt.Sprintf("%#v", this.Expression)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateBatchResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.EvaluateBatchResponse{")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "ResultDecimal: "+fmt.Sprintf("%#v", this.ResultDecimal)+",\n")
+	s = append(s, "ResultFraction: "+fmt.Sprintf("%#v", this.ResultFraction)+",\n")
+	if this.Trace != nil {
+		s = append(s, "Trace: "+fmt.Sprintf("%#v", this.Trace)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TraceStep) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.TraceStep{")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "Stack: "+fmt.Sprintf("%#v", this.Stack)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Trace) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.Trace{")
+	if this.Steps != nil {
+		s = append(s, "Steps: "+fmt.Sprintf("%#v", this.Steps)+",\n")
+	}
+	s = append(s, "Truncated: "+fmt.Sprintf("%#v", this.Truncated)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateExpressionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.EvaluateExpressionRequest{")
+	s = append(s, "Expression: "+fmt.Sprintf("%#v", this.Expression)+",\n")
+	s = append(s, "Notation: "+fmt.Sprintf("%#v", this.Notation)+",\n")
+	s = append(s, "MaxStackDepth: "+fmt.Sprintf("%#v", this.MaxStackDepth)+",\n")
+	keysForBindings := make([]string, 0, len(this.Bindings))
+	for k, _ := range this.Bindings {
+		keysForBindings = append(keysForBindings, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBindings)
+	mapStringForBindings := "map[string]*Operand{"
+	for _, k := range keysForBindings {
+		mapStringForBindings += fmt.Sprintf("%#v: %#v,", k, this.Bindings[k])
+	}
+	mapStringForBindings += "}"
+	if this.Bindings != nil {
+		s = append(s, "Bindings: "+mapStringForBindings+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateExpressionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.EvaluateExpressionResponse{")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Column) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.Column{")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateVectorRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.EvaluateVectorRequest{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	keysForColumns := make([]string, 0, len(this.Columns))
+	for k, _ := range this.Columns {
+		keysForColumns = append(keysForColumns, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForColumns)
+	mapStringForColumns := "map[string]*Column{"
+	for _, k := range keysForColumns {
+		mapStringForColumns += fmt.Sprintf("%#v: %#v,", k, this.Columns[k])
+	}
+	mapStringForColumns += "}"
+	if this.Columns != nil {
+		s = append(s, "Columns: "+mapStringForColumns+",\n")
+	}
+	s = append(s, "MaxStackDepth: "+fmt.Sprintf("%#v", this.MaxStackDepth)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RowError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.RowError{")
+	s = append(s, "Row: "+fmt.Sprintf("%#v", this.Row)+",\n")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluateVectorResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.EvaluateVectorResponse{")
+	s = append(s, "Results: "+fmt.Sprintf("%#v", this.Results)+",\n")
+	if this.Errors != nil {
+		s = append(s, "Errors: "+fmt.Sprintf("%#v", this.Errors)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompileRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.CompileRequest{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompileResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.CompileResponse{")
+	s = append(s, "ProgramId: "+fmt.Sprintf("%#v", this.ProgramId)+",\n")
+	s = append(s, "StackDepth: "+fmt.Sprintf("%#v", this.StackDepth)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExecuteRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.ExecuteRequest{")
+	s = append(s, "ProgramId: "+fmt.Sprintf("%#v", this.ProgramId)+",\n")
+	keysForBindings := make([]string, 0, len(this.Bindings))
+	for k, _ := range this.Bindings {
+		keysForBindings = append(keysForBindings, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBindings)
+	mapStringForBindings := "map[string]*Operand{"
+	for _, k := range keysForBindings {
+		mapStringForBindings += fmt.Sprintf("%#v: %#v,", k, this.Bindings[k])
+	}
+	mapStringForBindings += "}"
+	if this.Bindings != nil {
+		s = append(s, "Bindings: "+mapStringForBindings+",\n")
+	}
+	s = append(s, "Precision: "+fmt.Sprintf("%#v", this.Precision)+",\n")
+	s = append(s, "FloatPrecision: "+fmt.Sprintf("%#v", this.FloatPrecision)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExecuteResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.ExecuteResponse{")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "ResultDecimal: "+fmt.Sprintf("%#v", this.ResultDecimal)+",\n")
+	s = append(s, "ResultFraction: "+fmt.Sprintf("%#v", this.ResultFraction)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.ValidateRequest{")
+	s = append(s, "Expression: "+fmt.Sprintf("%#v", this.Expression)+",\n")
+	s = append(s, "Notation: "+fmt.Sprintf("%#v", this.Notation)+",\n")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "MaxStackDepth: "+fmt.Sprintf("%#v", this.MaxStackDepth)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidationProblem) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.ValidationProblem{")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "Position: "+fmt.Sprintf("%#v", this.Position)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidateResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.ValidateResponse{")
+	s = append(s, "Valid: "+fmt.Sprintf("%#v", this.Valid)+",\n")
+	if this.Problems != nil {
+		s = append(s, "Problems: "+fmt.Sprintf("%#v", this.Problems)+",\n")
+	}
+	s = append(s, "MaxStackDepth: "+fmt.Sprintf("%#v", this.MaxStackDepth)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvertRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.ConvertRequest{")
+	s = append(s, "Expression: "+fmt.Sprintf("%#v", this.Expression)+",\n")
+	s = append(s, "Notation: "+fmt.Sprintf("%#v", this.Notation)+",\n")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "TargetNotation: "+fmt.Sprintf("%#v", this.TargetNotation)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvertResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.ConvertResponse{")
+	s = append(s, "Expression: "+fmt.Sprintf("%#v", this.Expression)+",\n")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateSessionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.CreateSessionRequest{")
+	s = append(s, "Precision: "+fmt.Sprintf("%#v", this.Precision)+",\n")
+	s = append(s, "FloatPrecision: "+fmt.Sprintf("%#v", this.FloatPrecision)+",\n")
+	s = append(s, "MaxStackDepth: "+fmt.Sprintf("%#v", this.MaxStackDepth)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Session) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&v1pb.Session{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Stack: "+fmt.Sprintf("%#v", this.Stack)+",\n")
+	keysForRegisters := make([]string, 0, len(this.Registers))
+	for k, _ := range this.Registers {
+		keysForRegisters = append(keysForRegisters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRegisters)
+	mapStringForRegisters := "map[string]string{"
+	for _, k := range keysForRegisters {
+		mapStringForRegisters += fmt.Sprintf("%#v: %#v,", k, this.Registers[k])
+	}
+	mapStringForRegisters += "}"
+	if this.Registers != nil {
+		s = append(s, "Registers: "+mapStringForRegisters+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateSessionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.CreateSessionResponse{")
+	if this.Session != nil {
+		s = append(s, "Session: "+fmt.Sprintf("%#v", this.Session)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PushRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.PushRequest{")
+	s = append(s, "SessionId: "+fmt.Sprintf("%#v", this.SessionId)+",\n")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PushResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.PushResponse{")
+	if this.Session != nil {
+		s = append(s, "Session: "+fmt.Sprintf("%#v", this.Session)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetSessionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.GetSessionRequest{")
+	s = append(s, "SessionId: "+fmt.Sprintf("%#v", this.SessionId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetSessionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.GetSessionResponse{")
+	if this.Session != nil {
+		s = append(s, "Session: "+fmt.Sprintf("%#v", this.Session)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteSessionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.DeleteSessionRequest{")
+	s = append(s, "SessionId: "+fmt.Sprintf("%#v", this.SessionId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteSessionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1pb.DeleteSessionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HistoryEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&v1pb.HistoryEntry{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "TimeUnixNano: "+fmt.Sprintf("%#v", this.TimeUnixNano)+",\n")
+	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
+	s = append(s, "Caller: "+fmt.Sprintf("%#v", this.Caller)+",\n")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	if this.Expression != nil {
+		s = append(s, "Expression: "+fmt.Sprintf("%#v", this.Expression)+",\n")
+	}
+	s = append(s, "Precision: "+fmt.Sprintf("%#v", this.Precision)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "ResultDecimal: "+fmt.Sprintf("%#v", this.ResultDecimal)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "Truncated: "+fmt.Sprintf("%#v", this.Truncated)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListHistoryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.ListHistoryRequest{")
+	s = append(s, "StartTimeUnixNano: "+fmt.Sprintf("%#v", this.StartTimeUnixNano)+",\n")
+	s = append(s, "EndTimeUnixNano: "+fmt.Sprintf("%#v", this.EndTimeUnixNano)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListHistoryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.ListHistoryResponse{")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ParseError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&v1pb.ParseError{")
+	s = append(s, "Position: "+fmt.Sprintf("%#v", this.Position)+",\n")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvaluationError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&v1pb.EvaluationError{")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "TokenIndex: "+fmt.Sprintf("%#v", this.TokenIndex)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "StackDepth: "+fmt.Sprintf("%#v", this.StackDepth)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StackDepthExceeded) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&v1pb.StackDepthExceeded{")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCalculator(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CalculatorClient is the client API for Calculator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CalculatorClient interface {
+	EvaluateStream(ctx context.Context, opts ...grpc.CallOption) (Calculator_EvaluateStreamClient, error)
+	EvaluateInteractive(ctx context.Context, opts ...grpc.CallOption) (Calculator_EvaluateInteractiveClient, error)
+	EvaluateBatch(ctx context.Context, in *EvaluateBatchRequest, opts ...grpc.CallOption) (*EvaluateBatchResponse, error)
+	EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error)
+	EvaluateVector(ctx context.Context, in *EvaluateVectorRequest, opts ...grpc.CallOption) (*EvaluateVectorResponse, error)
+	Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (*CompileResponse, error)
+	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error)
+	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error)
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error)
+	ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error)
+}
+
+type calculatorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCalculatorClient(cc *grpc.ClientConn) CalculatorClient {
+	return &calculatorClient{cc}
+}
+
+func (c *calculatorClient) EvaluateStream(ctx context.Context, opts ...grpc.CallOption) (Calculator_EvaluateStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[0], "/com.github.charithe.calculator.v1.Calculator/EvaluateStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorEvaluateStreamClient{stream}
+	return x, nil
+}
+
+type Calculator_EvaluateStreamClient interface {
+	Send(*EvaluateStreamRequest) error
+	CloseAndRecv() (*EvaluateStreamResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorEvaluateStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorEvaluateStreamClient) Send(m *EvaluateStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorEvaluateStreamClient) CloseAndRecv() (*EvaluateStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(EvaluateStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorClient) EvaluateInteractive(ctx context.Context, opts ...grpc.CallOption) (Calculator_EvaluateInteractiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[1], "/com.github.charithe.calculator.v1.Calculator/EvaluateInteractive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorEvaluateInteractiveClient{stream}
+	return x, nil
+}
+
+type Calculator_EvaluateInteractiveClient interface {
+	Send(*EvaluateInteractiveRequest) error
+	Recv() (*EvaluateInteractiveResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorEvaluateInteractiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorEvaluateInteractiveClient) Send(m *EvaluateInteractiveRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorEvaluateInteractiveClient) Recv() (*EvaluateInteractiveResponse, error) {
+	m := new(EvaluateInteractiveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorClient) EvaluateBatch(ctx context.Context, in *EvaluateBatchRequest, opts ...grpc.CallOption) (*EvaluateBatchResponse, error) {
+	out := new(EvaluateBatchResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/EvaluateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error) {
+	out := new(EvaluateExpressionResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/EvaluateExpression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) EvaluateVector(ctx context.Context, in *EvaluateVectorRequest, opts ...grpc.CallOption) (*EvaluateVectorResponse, error) {
+	out := new(EvaluateVectorResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/EvaluateVector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (*CompileResponse, error) {
+	out := new(CompileResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/Compile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error) {
+	out := new(ExecuteResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/Execute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error) {
+	out := new(ConvertResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/Convert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error) {
+	out := new(CreateSessionResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/CreateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/Push", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error) {
+	out := new(GetSessionResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/GetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error) {
+	out := new(DeleteSessionResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/DeleteSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error) {
+	out := new(ListHistoryResponse)
+	err := c.cc.Invoke(ctx, "/com.github.charithe.calculator.v1.Calculator/ListHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalculatorServer is the server API for Calculator service.
+type CalculatorServer interface {
+	EvaluateStream(Calculator_EvaluateStreamServer) error
+	EvaluateInteractive(Calculator_EvaluateInteractiveServer) error
+	EvaluateBatch(context.Context, *EvaluateBatchRequest) (*EvaluateBatchResponse, error)
+	EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error)
+	EvaluateVector(context.Context, *EvaluateVectorRequest) (*EvaluateVectorResponse, error)
+	Compile(context.Context, *CompileRequest) (*CompileResponse, error)
+	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	Convert(context.Context, *ConvertRequest) (*ConvertResponse, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	Push(context.Context, *PushRequest) (*PushResponse, error)
+	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	ListHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
+}
+
+func RegisterCalculatorServer(s *grpc.Server, srv CalculatorServer) {
+	s.RegisterService(&_Calculator_serviceDesc, srv)
+}
+
+func _Calculator_EvaluateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServer).EvaluateStream(&calculatorEvaluateStreamServer{stream})
+}
+
+type Calculator_EvaluateStreamServer interface {
+	SendAndClose(*EvaluateStreamResponse) error
+	Recv() (*EvaluateStreamRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorEvaluateStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorEvaluateStreamServer) SendAndClose(m *EvaluateStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorEvaluateStreamServer) Recv() (*EvaluateStreamRequest, error) {
+	m := new(EvaluateStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Calculator_EvaluateInteractive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServer).EvaluateInteractive(&calculatorEvaluateInteractiveServer{stream})
+}
+
+type Calculator_EvaluateInteractiveServer interface {
+	Send(*EvaluateInteractiveResponse) error
+	Recv() (*EvaluateInteractiveRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorEvaluateInteractiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorEvaluateInteractiveServer) Send(m *EvaluateInteractiveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorEvaluateInteractiveServer) Recv() (*EvaluateInteractiveRequest, error) {
+	m := new(EvaluateInteractiveRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Calculator_EvaluateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).EvaluateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/EvaluateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).EvaluateBatch(ctx, req.(*EvaluateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_EvaluateExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).EvaluateExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/EvaluateExpression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).EvaluateExpression(ctx, req.(*EvaluateExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_EvaluateVector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateVectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).EvaluateVector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/EvaluateVector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).EvaluateVector(ctx, req.(*EvaluateVectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Compile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Compile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/Compile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Compile(ctx, req.(*CompileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Execute(ctx, req.(*ExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/Convert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/CreateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Push_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Push(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/Push",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Push(ctx, req.(*PushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/GetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/DeleteSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_ListHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).ListHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.github.charithe.calculator.v1.Calculator/ListHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).ListHistory(ctx, req.(*ListHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Calculator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "com.github.charithe.calculator.v1.Calculator",
+	HandlerType: (*CalculatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EvaluateBatch",
+			Handler:    _Calculator_EvaluateBatch_Handler,
+		},
+		{
+			MethodName: "EvaluateExpression",
+			Handler:    _Calculator_EvaluateExpression_Handler,
+		},
+		{
+			MethodName: "EvaluateVector",
+			Handler:    _Calculator_EvaluateVector_Handler,
+		},
+		{
+			MethodName: "Compile",
+			Handler:    _Calculator_Compile_Handler,
+		},
+		{
+			MethodName: "Execute",
+			Handler:    _Calculator_Execute_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _Calculator_Validate_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _Calculator_Convert_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _Calculator_CreateSession_Handler,
+		},
+		{
+			MethodName: "Push",
+			Handler:    _Calculator_Push_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _Calculator_GetSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _Calculator_DeleteSession_Handler,
+		},
+		{
+			MethodName: "ListHistory",
+			Handler:    _Calculator_ListHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EvaluateStream",
+			Handler:       _Calculator_EvaluateStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "EvaluateInteractive",
+			Handler:       _Calculator_EvaluateInteractive_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pkg/v1pb/calculator.proto",
+}
+
+func (m *Operand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operand) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i += 8
+	}
+	if len(m.Decimal) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Decimal)))
+		i += copy(dAtA[i:], m.Decimal)
+	}
+	return i, nil
+}
+
+func (m *Function) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Function) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Arity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Arity))
+	}
+	return i, nil
+}
+
+func (m *Variable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Variable) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *Register) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Register) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Op != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Op))
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Token) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		nn1, err := m.Token.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn1
+	}
+	return i, nil
+}
+
+func (m *Token_Operand) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Operand != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Operand.Size()))
+		n2, err := m.Operand.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *Token_Operator) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintCalculator(dAtA, i, uint64(m.Operator))
+	return i, nil
+}
+func (m *Token_Function) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Function != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Function.Size()))
+		n3, err := m.Function.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *Token_StackOp) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintCalculator(dAtA, i, uint64(m.StackOp))
+	return i, nil
+}
+func (m *Token_Variable) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Variable != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Variable.Size()))
+		n4, err := m.Variable.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+func (m *Token_Register) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Register != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Register.Size()))
+		n5, err := m.Register.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+func (m *Expression) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Expression) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Expression != nil {
+		nn6, err := m.Expression.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn6
+	}
+	return i, nil
+}
+
+func (m *Expression_Literal) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Literal != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Literal.Size()))
+		n7, err := m.Literal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+func (m *Expression_Variable) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Variable != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Variable.Size()))
+		n8, err := m.Variable.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+func (m *Expression_Binary) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Binary != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Binary.Size()))
+		n9, err := m.Binary.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+func (m *Expression_Call) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Call != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Call.Size()))
+		n10, err := m.Call.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *BinaryExpression) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BinaryExpression) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Operator != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Operator))
+	}
+	if m.Left != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Left.Size()))
+		n11, err := m.Left.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Right != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Right.Size()))
+		n12, err := m.Right.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+
+func (m *Call) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Call) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Args) > 0 {
+		for _, msg := range m.Args {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *EvaluateStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Token.Size()))
+		n13, err := m.Token.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Precision != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Precision))
+	}
+	if m.FloatPrecision != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.FloatPrecision))
+	}
+	if m.MaxStackDepth != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.MaxStackDepth))
+	}
+	if m.Explain {
+		dAtA[i] = 0x28
+		i++
+		if m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *EvaluateStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Result))))
+		i += 8
+	}
+	if len(m.ResultDecimal) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ResultDecimal)))
+		i += copy(dAtA[i:], m.ResultDecimal)
+	}
+	if len(m.ResultFraction) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ResultFraction)))
+		i += copy(dAtA[i:], m.ResultFraction)
+	}
+	if m.Trace != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Trace.Size()))
+		n14, err := m.Trace.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
+func (m *EvaluateInteractiveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateInteractiveRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Token.Size()))
+		n15, err := m.Token.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.MaxStackDepth != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.MaxStackDepth))
+	}
+	return i, nil
+}
+
+func (m *EvaluateInteractiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateInteractiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StackTop != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.StackTop))))
+		i += 8
+	}
+	if m.StackDepth != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.StackDepth))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *EvaluateBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Precision != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Precision))
+	}
+	if m.FloatPrecision != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.FloatPrecision))
+	}
+	if m.MaxStackDepth != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.MaxStackDepth))
+	}
+	if len(m.Bindings) > 0 {
+		for k, _ := range m.Bindings {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Bindings[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovCalculator(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovCalculator(uint64(len(k))) + msgSize
+			i = encodeVarintCalculator(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintCalculator(dAtA, i, uint64(v.Size()))
+				n16, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n16
+			}
+		}
+	}
+	if m.Explain {
+		dAtA[i] = 0x30
+		i++
+		if m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Expression != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Expression.Size()))
+		n17, err := m.Expression.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+
+func (m *EvaluateBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Result))))
+		i += 8
+	}
+	if len(m.ResultDecimal) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ResultDecimal)))
+		i += copy(dAtA[i:], m.ResultDecimal)
+	}
+	if len(m.ResultFraction) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ResultFraction)))
+		i += copy(dAtA[i:], m.ResultFraction)
+	}
+	if m.Trace != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Trace.Size()))
+		n18, err := m.Trace.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+
+func (m *TraceStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceStep) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Token)))
+		i += copy(dAtA[i:], m.Token)
+	}
+	if len(m.Stack) > 0 {
+		for _, s := range m.Stack {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *Trace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trace) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, msg := range m.Steps {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Truncated {
+		dAtA[i] = 0x10
+		i++
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *EvaluateExpressionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateExpressionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expression) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Expression)))
+		i += copy(dAtA[i:], m.Expression)
+	}
+	if m.Notation != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Notation))
+	}
+	if m.MaxStackDepth != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.MaxStackDepth))
+	}
+	if len(m.Bindings) > 0 {
+		for k, _ := range m.Bindings {
+			dAtA[i] = 0x22
+			i++
+			v := m.Bindings[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovCalculator(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovCalculator(uint64(len(k))) + msgSize
+			i = encodeVarintCalculator(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintCalculator(dAtA, i, uint64(v.Size()))
+				n19, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n19
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *EvaluateExpressionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateExpressionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Result))))
+		i += 8
+	}
+	return i, nil
+}
+
+func (m *Column) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Column) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Values)*8))
+		for _, num := range m.Values {
+			f20 := math.Float64bits(float64(num))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f20))
+			i += 8
+		}
+	}
+	return i, nil
+}
+
+func (m *EvaluateVectorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateVectorRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Columns) > 0 {
+		for k, _ := range m.Columns {
+			dAtA[i] = 0x12
+			i++
+			v := m.Columns[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovCalculator(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovCalculator(uint64(len(k))) + msgSize
+			i = encodeVarintCalculator(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintCalculator(dAtA, i, uint64(v.Size()))
+				n21, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n21
+			}
+		}
+	}
+	if m.MaxStackDepth != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.MaxStackDepth))
+	}
+	return i, nil
+}
+
+func (m *RowError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RowError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Row != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Row))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Error != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Error.Size()))
+		n22, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	return i, nil
+}
+
+func (m *EvaluateVectorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluateVectorResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Results)*8))
+		for _, num := range m.Results {
+			f23 := math.Float64bits(float64(num))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f23))
+			i += 8
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, msg := range m.Errors {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CompileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ProgramId)))
+		i += copy(dAtA[i:], m.ProgramId)
+	}
+	if m.StackDepth != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.StackDepth))
+	}
+	return i, nil
+}
+
+func (m *ExecuteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ProgramId)))
+		i += copy(dAtA[i:], m.ProgramId)
+	}
+	if len(m.Bindings) > 0 {
+		for k, _ := range m.Bindings {
+			dAtA[i] = 0x12
+			i++
+			v := m.Bindings[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovCalculator(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovCalculator(uint64(len(k))) + msgSize
+			i = encodeVarintCalculator(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintCalculator(dAtA, i, uint64(v.Size()))
+				n24, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n24
+			}
+		}
+	}
+	if m.Precision != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Precision))
+	}
+	if m.FloatPrecision != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.FloatPrecision))
+	}
+	return i, nil
+}
+
+func (m *ExecuteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Result))))
+		i += 8
+	}
+	if len(m.ResultDecimal) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ResultDecimal)))
+		i += copy(dAtA[i:], m.ResultDecimal)
+	}
+	if len(m.ResultFraction) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ResultFraction)))
+		i += copy(dAtA[i:], m.ResultFraction)
+	}
+	return i, nil
+}
+
+func (m *ValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expression) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Expression)))
+		i += copy(dAtA[i:], m.Expression)
+	}
+	if m.Notation != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Notation))
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MaxStackDepth != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.MaxStackDepth))
+	}
+	return i, nil
+}
+
+func (m *ValidationProblem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationProblem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Error != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Error.Size()))
+		n25, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if m.Position != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Position))
+	}
+	return i, nil
+}
+
+func (m *ValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		dAtA[i] = 0x8
+		i++
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Problems) > 0 {
+		for _, msg := range m.Problems {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MaxStackDepth != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.MaxStackDepth))
+	}
+	return i, nil
+}
+
+func (m *ConvertRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expression) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Expression)))
+		i += copy(dAtA[i:], m.Expression)
+	}
+	if m.Notation != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Notation))
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.TargetNotation != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.TargetNotation))
+	}
+	return i, nil
+}
+
+func (m *ConvertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expression) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Expression)))
+		i += copy(dAtA[i:], m.Expression)
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Precision != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Precision))
+	}
+	if m.FloatPrecision != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.FloatPrecision))
+	}
+	if m.MaxStackDepth != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.MaxStackDepth))
+	}
+	return i, nil
+}
+
+func (m *Session) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Stack) > 0 {
+		for _, s := range m.Stack {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Registers) > 0 {
+		for k, _ := range m.Registers {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Registers[k]
+			mapSize := 1 + len(k) + sovCalculator(uint64(len(k))) + 1 + len(v) + sovCalculator(uint64(len(v)))
+			i = encodeVarintCalculator(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Session != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Session.Size()))
+		n26, err := m.Session.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	return i, nil
+}
+
+func (m *PushRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.SessionId)))
+		i += copy(dAtA[i:], m.SessionId)
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PushResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Session != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Session.Size()))
+		n27, err := m.Session.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	return i, nil
+}
+
+func (m *GetSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.SessionId)))
+		i += copy(dAtA[i:], m.SessionId)
+	}
+	return i, nil
+}
+
+func (m *GetSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Session != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Session.Size()))
+		n28, err := m.Session.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+
+func (m *DeleteSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.SessionId)))
+		i += copy(dAtA[i:], m.SessionId)
+	}
+	return i, nil
+}
+
+func (m *DeleteSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *HistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Id))
+	}
+	if m.TimeUnixNano != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.TimeUnixNano))
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Caller) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Caller)))
+		i += copy(dAtA[i:], m.Caller)
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Expression != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Expression.Size()))
+		n29, err := m.Expression.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.Precision != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Precision))
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x41
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Result))))
+		i += 8
+	}
+	if len(m.ResultDecimal) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.ResultDecimal)))
+		i += copy(dAtA[i:], m.ResultDecimal)
+	}
+	if m.Code != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Truncated {
+		dAtA[i] = 0x60
+		i++
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ListHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StartTimeUnixNano != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.StartTimeUnixNano))
+	}
+	if m.EndTimeUnixNano != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.EndTimeUnixNano))
+	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	return i, nil
+}
+
+func (m *ListHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCalculator(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	return i, nil
+}
+
+func (m *ParseError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParseError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Position != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Position))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	return i, nil
+}
+
+func (m *EvaluationError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvaluationError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Reason))
+	}
+	if m.TokenIndex != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.TokenIndex))
+	}
+	if len(m.Token) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(len(m.Token)))
+		i += copy(dAtA[i:], m.Token)
+	}
+	if m.StackDepth != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.StackDepth))
+	}
+	return i, nil
+}
+
+func (m *StackDepthExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StackDepthExceeded) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCalculator(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func encodeVarintCalculator(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Operand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 9
+	}
+	l = len(m.Decimal)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *Function) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Arity != 0 {
+		n += 1 + sovCalculator(uint64(m.Arity))
+	}
+	return n
+}
+
+func (m *Variable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *Register) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovCalculator(uint64(m.Op))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		n += m.Token.Size()
+	}
+	return n
+}
+
+func (m *Token_Operand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operand != nil {
+		l = m.Operand.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+func (m *Token_Operator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovCalculator(uint64(m.Operator))
+	return n
+}
+func (m *Token_Function) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Function != nil {
+		l = m.Function.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+func (m *Token_StackOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovCalculator(uint64(m.StackOp))
+	return n
+}
+func (m *Token_Variable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Variable != nil {
+		l = m.Variable.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+func (m *Token_Register) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Register != nil {
+		l = m.Register.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+func (m *Expression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expression != nil {
+		n += m.Expression.Size()
+	}
+	return n
+}
+
+func (m *Expression_Literal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Literal != nil {
+		l = m.Literal.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+func (m *Expression_Variable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Variable != nil {
+		l = m.Variable.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+func (m *Expression_Binary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Binary != nil {
+		l = m.Binary.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+func (m *Expression_Call) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Call != nil {
+		l = m.Call.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+func (m *BinaryExpression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operator != 0 {
+		n += 1 + sovCalculator(uint64(m.Operator))
+	}
+	if m.Left != nil {
+		l = m.Left.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Right != nil {
+		l = m.Right.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *Call) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvaluateStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovCalculator(uint64(m.Precision))
+	}
+	if m.FloatPrecision != 0 {
+		n += 1 + sovCalculator(uint64(m.FloatPrecision))
+	}
+	if m.MaxStackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.MaxStackDepth))
+	}
+	if m.Explain {
+		n += 2
+	}
+	return n
+}
+
+func (m *EvaluateStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 9
+	}
+	l = len(m.ResultDecimal)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	l = len(m.ResultFraction)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Trace != nil {
+		l = m.Trace.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *EvaluateInteractiveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.MaxStackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.MaxStackDepth))
+	}
+	return n
+}
+
+func (m *EvaluateInteractiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StackTop != 0 {
+		n += 9
+	}
+	if m.StackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.StackDepth))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *EvaluateBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	if m.Precision != 0 {
+		n += 1 + sovCalculator(uint64(m.Precision))
+	}
+	if m.FloatPrecision != 0 {
+		n += 1 + sovCalculator(uint64(m.FloatPrecision))
+	}
+	if m.MaxStackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.MaxStackDepth))
+	}
+	if len(m.Bindings) > 0 {
+		for k, v := range m.Bindings {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovCalculator(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovCalculator(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovCalculator(uint64(mapEntrySize))
+		}
+	}
+	if m.Explain {
+		n += 2
+	}
+	if m.Expression != nil {
+		l = m.Expression.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *EvaluateBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 9
+	}
+	l = len(m.ResultDecimal)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	l = len(m.ResultFraction)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Trace != nil {
+		l = m.Trace.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *TraceStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if len(m.Stack) > 0 {
+		for _, s := range m.Stack {
+			l = len(s)
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Trace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *EvaluateExpressionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Notation != 0 {
+		n += 1 + sovCalculator(uint64(m.Notation))
+	}
+	if m.MaxStackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.MaxStackDepth))
+	}
+	if len(m.Bindings) > 0 {
+		for k, v := range m.Bindings {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovCalculator(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovCalculator(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovCalculator(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *EvaluateExpressionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *Column) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		n += 1 + sovCalculator(uint64(len(m.Values)*8)) + len(m.Values)*8
+	}
+	return n
+}
+
+func (m *EvaluateVectorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	if len(m.Columns) > 0 {
+		for k, v := range m.Columns {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovCalculator(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovCalculator(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovCalculator(uint64(mapEntrySize))
+		}
+	}
+	if m.MaxStackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.MaxStackDepth))
+	}
+	return n
+}
+
+func (m *RowError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Row != 0 {
+		n += 1 + sovCalculator(uint64(m.Row))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *EvaluateVectorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		n += 1 + sovCalculator(uint64(len(m.Results)*8)) + len(m.Results)*8
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.StackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.StackDepth))
+	}
+	return n
+}
+
+func (m *ExecuteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if len(m.Bindings) > 0 {
+		for k, v := range m.Bindings {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovCalculator(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovCalculator(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovCalculator(uint64(mapEntrySize))
+		}
+	}
+	if m.Precision != 0 {
+		n += 1 + sovCalculator(uint64(m.Precision))
+	}
+	if m.FloatPrecision != 0 {
+		n += 1 + sovCalculator(uint64(m.FloatPrecision))
+	}
+	return n
+}
+
+func (m *ExecuteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 9
+	}
+	l = len(m.ResultDecimal)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	l = len(m.ResultFraction)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Notation != 0 {
+		n += 1 + sovCalculator(uint64(m.Notation))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	if m.MaxStackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.MaxStackDepth))
+	}
+	return n
+}
+
+func (m *ValidationProblem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovCalculator(uint64(m.Position))
+	}
+	return n
+}
+
+func (m *ValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Problems) > 0 {
+		for _, e := range m.Problems {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	if m.MaxStackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.MaxStackDepth))
+	}
+	return n
+}
+
+func (m *ConvertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Notation != 0 {
+		n += 1 + sovCalculator(uint64(m.Notation))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	if m.TargetNotation != 0 {
+		n += 1 + sovCalculator(uint64(m.TargetNotation))
+	}
+	return n
+}
+
+func (m *ConvertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Precision != 0 {
+		n += 1 + sovCalculator(uint64(m.Precision))
+	}
+	if m.FloatPrecision != 0 {
+		n += 1 + sovCalculator(uint64(m.FloatPrecision))
+	}
+	if m.MaxStackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.MaxStackDepth))
+	}
+	return n
+}
+
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if len(m.Stack) > 0 {
+		for _, s := range m.Stack {
+			l = len(s)
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	if len(m.Registers) > 0 {
+		for k, v := range m.Registers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCalculator(uint64(len(k))) + 1 + len(v) + sovCalculator(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCalculator(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *CreateSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *PushRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PushResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *HistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCalculator(uint64(m.Id))
+	}
+	if m.TimeUnixNano != 0 {
+		n += 1 + sovCalculator(uint64(m.TimeUnixNano))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	if m.Expression != nil {
+		l = m.Expression.Size()
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovCalculator(uint64(m.Precision))
+	}
+	if m.Result != 0 {
+		n += 9
+	}
+	l = len(m.ResultDecimal)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovCalculator(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTimeUnixNano != 0 {
+		n += 1 + sovCalculator(uint64(m.StartTimeUnixNano))
+	}
+	if m.EndTimeUnixNano != 0 {
+		n += 1 + sovCalculator(uint64(m.EndTimeUnixNano))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovCalculator(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *ListHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCalculator(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *ParseError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovCalculator(uint64(m.Position))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	return n
+}
+
+func (m *EvaluationError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovCalculator(uint64(m.Reason))
+	}
+	if m.TokenIndex != 0 {
+		n += 1 + sovCalculator(uint64(m.TokenIndex))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovCalculator(uint64(l))
+	}
+	if m.StackDepth != 0 {
+		n += 1 + sovCalculator(uint64(m.StackDepth))
+	}
+	return n
+}
+
+func (m *StackDepthExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovCalculator(uint64(m.Limit))
+	}
+	return n
+}
+
+func sovCalculator(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCalculator(x uint64) (n int) {
+	return sovCalculator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Operand) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Operand{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Decimal:` + fmt.Sprintf("%v", this.Decimal) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Function) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Function{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Arity:` + fmt.Sprintf("%v", this.Arity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Variable) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Variable{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Register) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Register{`,
+		`Op:` + fmt.Sprintf("%v", this.Op) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Token) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Token{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Token_Operand) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Token_Operand{`,
+		`Operand:` + strings.Replace(fmt.Sprintf("%v", this.Operand), "Operand", "Operand", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Token_Operator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Token_Operator{`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Token_Function) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Token_Function{`,
+		`Function:` + strings.Replace(fmt.Sprintf("%v", this.Function), "Function", "Function", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Token_StackOp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Token_StackOp{`,
+		`StackOp:` + fmt.Sprintf("%v", this.StackOp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Token_Variable) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Token_Variable{`,
+		`Variable:` + strings.Replace(fmt.Sprintf("%v", this.Variable), "Variable", "Variable", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Token_Register) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Token_Register{`,
+		`Register:` + strings.Replace(fmt.Sprintf("%v", this.Register), "Register", "Register", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Expression) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Expression{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Expression_Literal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Expression_Literal{`,
+		`Literal:` + strings.Replace(fmt.Sprintf("%v", this.Literal), "Operand", "Operand", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Expression_Variable) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Expression_Variable{`,
+		`Variable:` + strings.Replace(fmt.Sprintf("%v", this.Variable), "Variable", "Variable", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Expression_Binary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Expression_Binary{`,
+		`Binary:` + strings.Replace(fmt.Sprintf("%v", this.Binary), "BinaryExpression", "BinaryExpression", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Expression_Call) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Expression_Call{`,
+		`Call:` + strings.Replace(fmt.Sprintf("%v", this.Call), "Call", "Call", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BinaryExpression) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BinaryExpression{`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Left:` + strings.Replace(fmt.Sprintf("%v", this.Left), "Expression", "Expression", 1) + `,`,
+		`Right:` + strings.Replace(fmt.Sprintf("%v", this.Right), "Expression", "Expression", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Call) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Call{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Args:` + strings.Replace(fmt.Sprintf("%v", this.Args), "Expression", "Expression", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvaluateStreamRequest{`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "Token", "Token", 1) + `,`,
+		`Precision:` + fmt.Sprintf("%v", this.Precision) + `,`,
+		`FloatPrecision:` + fmt.Sprintf("%v", this.FloatPrecision) + `,`,
+		`MaxStackDepth:` + fmt.Sprintf("%v", this.MaxStackDepth) + `,`,
+		`Explain:` + fmt.Sprintf("%v", this.Explain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvaluateStreamResponse{`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`ResultDecimal:` + fmt.Sprintf("%v", this.ResultDecimal) + `,`,
+		`ResultFraction:` + fmt.Sprintf("%v", this.ResultFraction) + `,`,
+		`Trace:` + strings.Replace(fmt.Sprintf("%v", this.Trace), "Trace", "Trace", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateInteractiveRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvaluateInteractiveRequest{`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "Token", "Token", 1) + `,`,
+		`MaxStackDepth:` + fmt.Sprintf("%v", this.MaxStackDepth) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateInteractiveResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvaluateInteractiveResponse{`,
+		`StackTop:` + fmt.Sprintf("%v", this.StackTop) + `,`,
+		`StackDepth:` + fmt.Sprintf("%v", this.StackDepth) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateBatchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForBindings := make([]string, 0, len(this.Bindings))
+	for k, _ := range this.Bindings {
+		keysForBindings = append(keysForBindings, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBindings)
+	mapStringForBindings := "map[string]*Operand{"
+	for _, k := range keysForBindings {
+		mapStringForBindings += fmt.Sprintf("%v: %v,", k, this.Bindings[k])
+	}
+	mapStringForBindings += "}"
+	s := strings.Join([]string{`&EvaluateBatchRequest{`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
+		`Precision:` + fmt.Sprintf("%v", this.Precision) + `,`,
+		`FloatPrecision:` + fmt.Sprintf("%v", this.FloatPrecision) + `,`,
+		`MaxStackDepth:` + fmt.Sprintf("%v", this.MaxStackDepth) + `,`,
+		`Bindings:` + mapStringForBindings + `,`,
+		`Explain:` + fmt.Sprintf("%v", this.Explain) + `,`,
+		`Expression:` + strings.Replace(fmt.Sprintf("%v", this.Expression), "Expression", "Expression", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateBatchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvaluateBatchResponse{`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`ResultDecimal:` + fmt.Sprintf("%v", this.ResultDecimal) + `,`,
+		`ResultFraction:` + fmt.Sprintf("%v", this.ResultFraction) + `,`,
+		`Trace:` + strings.Replace(fmt.Sprintf("%v", this.Trace), "Trace", "Trace", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TraceStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TraceStep{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`Stack:` + fmt.Sprintf("%v", this.Stack) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Trace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Trace{`,
+		`Steps:` + strings.Replace(fmt.Sprintf("%v", this.Steps), "TraceStep", "TraceStep", 1) + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateExpressionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForBindings := make([]string, 0, len(this.Bindings))
+	for k, _ := range this.Bindings {
+		keysForBindings = append(keysForBindings, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBindings)
+	mapStringForBindings := "map[string]*Operand{"
+	for _, k := range keysForBindings {
+		mapStringForBindings += fmt.Sprintf("%v: %v,", k, this.Bindings[k])
+	}
+	mapStringForBindings += "}"
+	s := strings.Join([]string{`&EvaluateExpressionRequest{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Notation:` + fmt.Sprintf("%v", this.Notation) + `,`,
+		`MaxStackDepth:` + fmt.Sprintf("%v", this.MaxStackDepth) + `,`,
+		`Bindings:` + mapStringForBindings + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateExpressionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvaluateExpressionResponse{`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Column) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Column{`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateVectorRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForColumns := make([]string, 0, len(this.Columns))
+	for k, _ := range this.Columns {
+		keysForColumns = append(keysForColumns, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForColumns)
+	mapStringForColumns := "map[string]*Column{"
+	for _, k := range keysForColumns {
+		mapStringForColumns += fmt.Sprintf("%v: %v,", k, this.Columns[k])
+	}
+	mapStringForColumns += "}"
+	s := strings.Join([]string{`&EvaluateVectorRequest{`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
+		`Columns:` + mapStringForColumns + `,`,
+		`MaxStackDepth:` + fmt.Sprintf("%v", this.MaxStackDepth) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RowError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RowError{`,
+		`Row:` + fmt.Sprintf("%v", this.Row) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "EvaluationError", "EvaluationError", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluateVectorResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvaluateVectorResponse{`,
+		`Results:` + fmt.Sprintf("%v", this.Results) + `,`,
+		`Errors:` + strings.Replace(fmt.Sprintf("%v", this.Errors), "RowError", "RowError", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompileRequest{`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompileResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompileResponse{`,
+		`ProgramId:` + fmt.Sprintf("%v", this.ProgramId) + `,`,
+		`StackDepth:` + fmt.Sprintf("%v", this.StackDepth) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecuteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForBindings := make([]string, 0, len(this.Bindings))
+	for k, _ := range this.Bindings {
+		keysForBindings = append(keysForBindings, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBindings)
+	mapStringForBindings := "map[string]*Operand{"
+	for _, k := range keysForBindings {
+		mapStringForBindings += fmt.Sprintf("%v: %v,", k, this.Bindings[k])
+	}
+	mapStringForBindings += "}"
+	s := strings.Join([]string{`&ExecuteRequest{`,
+		`ProgramId:` + fmt.Sprintf("%v", this.ProgramId) + `,`,
+		`Bindings:` + mapStringForBindings + `,`,
+		`Precision:` + fmt.Sprintf("%v", this.Precision) + `,`,
+		`FloatPrecision:` + fmt.Sprintf("%v", this.FloatPrecision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecuteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecuteResponse{`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`ResultDecimal:` + fmt.Sprintf("%v", this.ResultDecimal) + `,`,
+		`ResultFraction:` + fmt.Sprintf("%v", this.ResultFraction) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidateRequest{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Notation:` + fmt.Sprintf("%v", this.Notation) + `,`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
+		`MaxStackDepth:` + fmt.Sprintf("%v", this.MaxStackDepth) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidationProblem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidationProblem{`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "EvaluationError", "EvaluationError", 1) + `,`,
+		`Position:` + fmt.Sprintf("%v", this.Position) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidateResponse{`,
+		`Valid:` + fmt.Sprintf("%v", this.Valid) + `,`,
+		`Problems:` + strings.Replace(fmt.Sprintf("%v", this.Problems), "ValidationProblem", "ValidationProblem", 1) + `,`,
+		`MaxStackDepth:` + fmt.Sprintf("%v", this.MaxStackDepth) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvertRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvertRequest{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Notation:` + fmt.Sprintf("%v", this.Notation) + `,`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
+		`TargetNotation:` + fmt.Sprintf("%v", this.TargetNotation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvertResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvertResponse{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateSessionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateSessionRequest{`,
+		`Precision:` + fmt.Sprintf("%v", this.Precision) + `,`,
+		`FloatPrecision:` + fmt.Sprintf("%v", this.FloatPrecision) + `,`,
+		`MaxStackDepth:` + fmt.Sprintf("%v", this.MaxStackDepth) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Session) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRegisters := make([]string, 0, len(this.Registers))
+	for k, _ := range this.Registers {
+		keysForRegisters = append(keysForRegisters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRegisters)
+	mapStringForRegisters := "map[string]string{"
+	for _, k := range keysForRegisters {
+		mapStringForRegisters += fmt.Sprintf("%v: %v,", k, this.Registers[k])
+	}
+	mapStringForRegisters += "}"
+	s := strings.Join([]string{`&Session{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Stack:` + fmt.Sprintf("%v", this.Stack) + `,`,
+		`Registers:` + mapStringForRegisters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateSessionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateSessionResponse{`,
+		`Session:` + strings.Replace(fmt.Sprintf("%v", this.Session), "Session", "Session", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushRequest{`,
+		`SessionId:` + fmt.Sprintf("%v", this.SessionId) + `,`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PushResponse{`,
+		`Session:` + strings.Replace(fmt.Sprintf("%v", this.Session), "Session", "Session", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSessionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSessionRequest{`,
+		`SessionId:` + fmt.Sprintf("%v", this.SessionId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSessionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSessionResponse{`,
+		`Session:` + strings.Replace(fmt.Sprintf("%v", this.Session), "Session", "Session", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteSessionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteSessionRequest{`,
+		`SessionId:` + fmt.Sprintf("%v", this.SessionId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteSessionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteSessionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryEntry{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`TimeUnixNano:` + fmt.Sprintf("%v", this.TimeUnixNano) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Caller:` + fmt.Sprintf("%v", this.Caller) + `,`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "Token", "Token", 1) + `,`,
+		`Expression:` + strings.Replace(fmt.Sprintf("%v", this.Expression), "Expression", "Expression", 1) + `,`,
+		`Precision:` + fmt.Sprintf("%v", this.Precision) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`ResultDecimal:` + fmt.Sprintf("%v", this.ResultDecimal) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListHistoryRequest{`,
+		`StartTimeUnixNano:` + fmt.Sprintf("%v", this.StartTimeUnixNano) + `,`,
+		`EndTimeUnixNano:` + fmt.Sprintf("%v", this.EndTimeUnixNano) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListHistoryResponse{`,
+		`Entries:` + strings.Replace(fmt.Sprintf("%v", this.Entries), "HistoryEntry", "HistoryEntry", 1) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParseError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParseError{`,
+		`Position:` + fmt.Sprintf("%v", this.Position) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvaluationError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvaluationError{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`TokenIndex:` + fmt.Sprintf("%v", this.TokenIndex) + `,`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`StackDepth:` + fmt.Sprintf("%v", this.StackDepth) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StackDepthExceeded) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StackDepthExceeded{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCalculator(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Operand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decimal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Function) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arity", wireType)
+			}
+			m.Arity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Arity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Variable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Variable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Variable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Register) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Register: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Register: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= RegisterOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operand", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operand{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Token = &Token_Operand{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var v Operator
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= Operator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Token = &Token_Operator{v}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Function{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Token = &Token_Function{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackOp", wireType)
+			}
+			var v StackOp
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= StackOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Token = &Token_StackOp{v}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Variable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Token = &Token_Variable{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Register", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Register{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Token = &Token_Register{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Expression) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Expression: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Expression: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Literal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operand{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Expression = &Expression_Literal{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Variable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Expression = &Expression_Variable{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BinaryExpression{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Expression = &Expression_Binary{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Call{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Expression = &Expression_Call{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BinaryExpression) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryExpression: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= Operator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Left == nil {
+				m.Left = &Expression{}
+			}
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Right == nil {
+				m.Right = &Expression{}
+			}
+			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Call) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Call: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Call: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, &Expression{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &Token{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= Precision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatPrecision", wireType)
+			}
+			m.FloatPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FloatPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackDepth", wireType)
+			}
+			m.MaxStackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Explain = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Result = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultDecimal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultDecimal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trace == nil {
+				m.Trace = &Trace{}
+			}
+			if err := m.Trace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateInteractiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateInteractiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateInteractiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &Token{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackDepth", wireType)
+			}
+			m.MaxStackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateInteractiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateInteractiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateInteractiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackTop", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.StackTop = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
+			}
+			m.StackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StackDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= Precision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatPrecision", wireType)
+			}
+			m.FloatPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FloatPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackDepth", wireType)
+			}
+			m.MaxStackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bindings == nil {
+				m.Bindings = make(map[string]*Operand)
+			}
+			var mapkey string
+			var mapvalue *Operand
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCalculator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Operand{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCalculator(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Bindings[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Explain = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expression == nil {
+				m.Expression = &Expression{}
+			}
+			if err := m.Expression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Result = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultDecimal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultDecimal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trace == nil {
+				m.Trace = &Trace{}
+			}
+			if err := m.Trace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraceStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraceStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraceStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stack = append(m.Stack, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, &TraceStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateExpressionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateExpressionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateExpressionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notation", wireType)
+			}
+			m.Notation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Notation |= Notation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackDepth", wireType)
+			}
+			m.MaxStackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bindings == nil {
+				m.Bindings = make(map[string]*Operand)
+			}
+			var mapkey string
+			var mapvalue *Operand
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCalculator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Operand{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCalculator(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Bindings[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateExpressionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateExpressionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateExpressionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Result = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Column) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Column: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Column: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Values = append(m.Values, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCalculator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCalculator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCalculator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Values) == 0 {
+					m.Values = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Values = append(m.Values, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateVectorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateVectorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateVectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Columns == nil {
+				m.Columns = make(map[string]*Column)
+			}
+			var mapkey string
+			var mapvalue *Column
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCalculator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Column{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCalculator(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Columns[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackDepth", wireType)
+			}
+			m.MaxStackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RowError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RowError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RowError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &EvaluationError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvaluateVectorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvaluateVectorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvaluateVectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Results = append(m.Results, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCalculator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCalculator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCalculator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Results = append(m.Results, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &RowError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
+			}
+			m.StackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bindings == nil {
+				m.Bindings = make(map[string]*Operand)
+			}
+			var mapkey string
+			var mapvalue *Operand
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCalculator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Operand{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCalculator(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Bindings[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= Precision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatPrecision", wireType)
+			}
+			m.FloatPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FloatPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Result = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultDecimal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultDecimal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notation", wireType)
+			}
+			m.Notation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Notation |= Notation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackDepth", wireType)
+			}
+			m.MaxStackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidationProblem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidationProblem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidationProblem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &EvaluationError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Problems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Problems = append(m.Problems, &ValidationProblem{})
+			if err := m.Problems[len(m.Problems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackDepth", wireType)
+			}
+			m.MaxStackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notation", wireType)
+			}
+			m.Notation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Notation |= Notation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNotation", wireType)
+			}
+			m.TargetNotation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNotation |= Notation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= Precision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatPrecision", wireType)
+			}
+			m.FloatPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FloatPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackDepth", wireType)
+			}
+			m.MaxStackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Session) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stack = append(m.Stack, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Registers == nil {
+				m.Registers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCalculator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCalculator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCalculator(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCalculator
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Registers[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &Session{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &Session{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &Session{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCalculator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCalculator
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1310,23 +15672,317 @@ func (m *Operand) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operand: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operand: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUnixNano", wireType)
+			}
+			m.TimeUnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeUnixNano |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expression == nil {
+				m.Expression = &Expression{}
+			}
+			if err := m.Expression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= Precision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Result = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultDecimal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultDecimal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Value = float64(math.Float64frombits(v))
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCalculator(dAtA[iNdEx:])
@@ -1351,7 +16007,7 @@ func (m *Operand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Token) Unmarshal(dAtA []byte) error {
+func (m *ListHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1374,17 +16030,17 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operand", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeUnixNano", wireType)
 			}
-			var msglen int
+			m.StartTimeUnixNano = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCalculator
@@ -1394,32 +16050,54 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartTimeUnixNano |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCalculator
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimeUnixNano", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCalculator
+			m.EndTimeUnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTimeUnixNano |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			v := &Operand{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Token = &Token_Operand{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var v Operator
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCalculator
@@ -1429,12 +16107,24 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= Operator(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Token = &Token_Operator{v}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCalculator(dAtA[iNdEx:])
@@ -1459,7 +16149,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvaluateStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *ListHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1482,15 +16172,15 @@ func (m *EvaluateStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvaluateStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvaluateStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1517,13 +16207,43 @@ func (m *EvaluateStreamRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &Token{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &HistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCalculator(dAtA[iNdEx:])
@@ -1548,7 +16268,7 @@ func (m *EvaluateStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvaluateStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *ParseError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1571,23 +16291,63 @@ func (m *EvaluateStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvaluateStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParseError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvaluateStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParseError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCalculator
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Result = float64(math.Float64frombits(v))
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCalculator(dAtA[iNdEx:])
@@ -1612,7 +16372,7 @@ func (m *EvaluateStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvaluateBatchRequest) Unmarshal(dAtA []byte) error {
+func (m *EvaluationError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1635,17 +16395,55 @@ func (m *EvaluateBatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvaluateBatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvaluationError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvaluateBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvaluationError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ErrorReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIndex", wireType)
+			}
+			m.TokenIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCalculator
@@ -1655,26 +16453,43 @@ func (m *EvaluateBatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCalculator
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCalculator
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &Token{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
+			}
+			m.StackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StackDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCalculator(dAtA[iNdEx:])
@@ -1699,7 +16514,7 @@ func (m *EvaluateBatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvaluateBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *StackDepthExceeded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1722,23 +16537,31 @@ func (m *EvaluateBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvaluateBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StackDepthExceeded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvaluateBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StackDepthExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCalculator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Result = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCalculator(dAtA[iNdEx:])
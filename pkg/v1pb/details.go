@@ -0,0 +1,12 @@
+package v1pb
+
+import golangproto "github.com/golang/protobuf/proto"
+
+// grpc-go marshals status details using the golang/protobuf registry. Messages that are sent as error details must be
+// registered there as well so that clients can decode them from the status.
+func init() {
+	golangproto.RegisterType((*ParseError)(nil), "com.github.charithe.calculator.v1.ParseError")
+	golangproto.RegisterType((*EvaluationError)(nil), "com.github.charithe.calculator.v1.EvaluationError")
+	golangproto.RegisterType((*Trace)(nil), "com.github.charithe.calculator.v1.Trace")
+	golangproto.RegisterType((*StackDepthExceeded)(nil), "com.github.charithe.calculator.v1.StackDepthExceeded")
+}